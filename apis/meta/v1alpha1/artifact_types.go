@@ -24,6 +24,8 @@ import (
 
 var ArtifactGVK = GroupVersion.WithKind("Artifact")
 var ArtifactListGVK = GroupVersion.WithKind("ArtifactList")
+var ArtifactTagGVK = GroupVersion.WithKind("ArtifactTag")
+var ArtifactTagListGVK = GroupVersion.WithKind("ArtifactTagList")
 
 // Artifact object for plugins
 type Artifact struct {
@@ -34,7 +36,6 @@ type Artifact struct {
 }
 
 // ArtifactSpec spec for repository
-// TODO: add more necessary spec data
 type ArtifactSpec struct {
 	// Address API related access URL
 	// +optional
@@ -54,11 +55,96 @@ type ArtifactSpec struct {
 	// +optional
 	UpdatedTime metav1.Time `json:"updatedTime"`
 
+	// Digest content digest of the artifact, e.g sha256:...
+	// +optional
+	Digest string `json:"digest,omitempty"`
+
+	// MediaType media type of the artifact manifest or index
+	// +optional
+	MediaType string `json:"mediaType,omitempty"`
+
+	// Size of the artifact in bytes
+	// +optional
+	Size int64 `json:"size,omitempty"`
+
+	// Tags all tags pointing to the artifact
+	// +optional
+	Tags []string `json:"tags,omitempty"`
+
+	// Layers of the artifact manifest
+	// +optional
+	Layers []ArtifactLayer `json:"layers,omitempty"`
+
+	// Manifests per-platform manifests when the artifact is an image index
+	// +optional
+	Manifests []ArtifactManifest `json:"manifests,omitempty"`
+
 	// Properties extended properties for Artifact
 	// +optional
 	Properties *runtime.RawExtension `json:"properties,omitempty"`
 }
 
+// ArtifactLayer layer of an artifact manifest
+type ArtifactLayer struct {
+	// Digest content digest of the layer
+	Digest string `json:"digest"`
+
+	// MediaType media type of the layer
+	// +optional
+	MediaType string `json:"mediaType,omitempty"`
+
+	// Size of the layer in bytes
+	// +optional
+	Size int64 `json:"size,omitempty"`
+}
+
+// ArtifactPlatform platform an artifact manifest was built for
+type ArtifactPlatform struct {
+	// OS operating system, e.g linux
+	OS string `json:"os"`
+
+	// Architecture cpu architecture, e.g amd64
+	Architecture string `json:"architecture"`
+
+	// Variant cpu variant, e.g v8
+	// +optional
+	Variant string `json:"variant,omitempty"`
+}
+
+// ArtifactManifest platform manifest of an image index
+type ArtifactManifest struct {
+	// Digest content digest of the manifest
+	Digest string `json:"digest"`
+
+	// MediaType media type of the manifest
+	// +optional
+	MediaType string `json:"mediaType,omitempty"`
+
+	// Size of the manifest in bytes
+	// +optional
+	Size int64 `json:"size,omitempty"`
+
+	// Platform the manifest was built for
+	// +optional
+	Platform *ArtifactPlatform `json:"platform,omitempty"`
+}
+
+// ManifestForPlatform returns the manifest matching os and architecture.
+// Variant is only compared when not empty. Returns nil if no manifest matches
+func (in *ArtifactSpec) ManifestForPlatform(os, architecture, variant string) *ArtifactManifest {
+	for i, manifest := range in.Manifests {
+		platform := manifest.Platform
+		if platform == nil || platform.OS != os || platform.Architecture != architecture {
+			continue
+		}
+		if variant != "" && platform.Variant != variant {
+			continue
+		}
+		return &in.Manifests[i]
+	}
+	return nil
+}
+
 // ArtifactList list of artifacts
 type ArtifactList struct {
 	metav1.TypeMeta `json:",inline"`
@@ -66,3 +152,47 @@ type ArtifactList struct {
 
 	Items []Artifact `json:"items"`
 }
+
+// ArtifactCopyOptions options to copy an artifact to another repository
+type ArtifactCopyOptions struct {
+	// Target project, repository and artifact name to copy to.
+	// Empty artifact name keeps the name of the source artifact
+	Target ArtifactOptions `json:"target"`
+
+	// Overwrite replaces the target artifact if it already exists
+	// +optional
+	Overwrite bool `json:"overwrite"`
+}
+
+// ArtifactTag tag of an artifact
+type ArtifactTag struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ArtifactTagSpec `json:"spec"`
+}
+
+// ArtifactTagSpec spec for artifact tag
+type ArtifactTagSpec struct {
+	// Name tag name
+	Name string `json:"name"`
+
+	// Digest content digest of the artifact the tag points to
+	Digest string `json:"digest"`
+
+	// PushedTime time the tag was pushed
+	// +optional
+	PushedTime *metav1.Time `json:"pushedTime,omitempty"`
+
+	// Properties extended properties for ArtifactTag
+	// +optional
+	Properties *runtime.RawExtension `json:"properties,omitempty"`
+}
+
+// ArtifactTagList list of artifact tags
+type ArtifactTagList struct {
+	metav1.TypeMeta `json:",inline"`
+	ListMeta        `json:"metadata,omitempty"`
+
+	Items []ArtifactTag `json:"items"`
+}
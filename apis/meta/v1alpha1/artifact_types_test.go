@@ -0,0 +1,70 @@
+/*
+Copyright 2021 The Katanomi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestArtifactSpecManifestForPlatform(t *testing.T) {
+	spec := ArtifactSpec{
+		Manifests: []ArtifactManifest{
+			{Digest: "sha256:none"},
+			{Digest: "sha256:amd64", Platform: &ArtifactPlatform{OS: "linux", Architecture: "amd64"}},
+			{Digest: "sha256:arm64v8", Platform: &ArtifactPlatform{OS: "linux", Architecture: "arm64", Variant: "v8"}},
+		},
+	}
+	table := map[string]struct {
+		OS           string
+		Architecture string
+		Variant      string
+		Digest       string
+	}{
+		"Match os and architecture": {
+			OS: "linux", Architecture: "amd64", Digest: "sha256:amd64",
+		},
+		"Empty variant matches any variant": {
+			OS: "linux", Architecture: "arm64", Digest: "sha256:arm64v8",
+		},
+		"Match variant": {
+			OS: "linux", Architecture: "arm64", Variant: "v8", Digest: "sha256:arm64v8",
+		},
+		"Variant mismatch": {
+			OS: "linux", Architecture: "arm64", Variant: "v7", Digest: "",
+		},
+		"Os mismatch": {
+			OS: "windows", Architecture: "amd64", Digest: "",
+		},
+	}
+
+	for name, item := range table {
+		test := item
+		t.Run(name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+
+			manifest := spec.ManifestForPlatform(test.OS, test.Architecture, test.Variant)
+			if test.Digest == "" {
+				g.Expect(manifest).To(BeNil())
+			} else {
+				g.Expect(manifest).NotTo(BeNil())
+				g.Expect(manifest.Digest).To(Equal(test.Digest))
+			}
+		})
+	}
+}
@@ -33,6 +33,42 @@ type CreateRepoFilePayload struct {
 	FilePath string `json:"filepath"`
 }
 
+// UpdateRepoFileParams params for update file in server
+type UpdateRepoFileParams struct {
+	// Branch target branch to update file
+	Branch string `json:"branch"`
+	// Message commit message for update the file
+	Message string `json:"message"`
+	// Content must be base64 encoded
+	Content []byte `json:"content"`
+	// SHA blob sha of the file being replaced, used to avoid overwriting concurrent changes
+	SHA string `json:"sha"`
+}
+
+// UpdateRepoFilePayload option for update file and commit + push
+type UpdateRepoFilePayload struct {
+	GitRepo
+	UpdateRepoFileParams
+	FilePath string `json:"filepath"`
+}
+
+// DeleteRepoFileParams params for delete file in server
+type DeleteRepoFileParams struct {
+	// Branch target branch to delete file
+	Branch string `json:"branch"`
+	// Message commit message for delete the file
+	Message string `json:"message"`
+	// SHA blob sha of the file being deleted, used to avoid overwriting concurrent changes
+	SHA string `json:"sha"`
+}
+
+// DeleteRepoFilePayload option for delete file and commit + push
+type DeleteRepoFilePayload struct {
+	GitRepo
+	DeleteRepoFileParams
+	FilePath string `json:"filepath"`
+}
+
 // CreateBranchParams params for create branch in server
 type CreateBranchParams struct {
 	// Branch new branch name
@@ -47,6 +83,62 @@ type CreateBranchPayload struct {
 	CreateBranchParams
 }
 
+// CreateTagParams params for create tag in server
+type CreateTagParams struct {
+	// Tag new tag name
+	Tag string `json:"tag"`
+	// Ref source commit sha or branch name
+	Ref string `json:"ref"`
+	// Message create annotated tag when not empty
+	Message string `json:"message,omitempty"`
+}
+
+// CreateTagPayload payload for create tag
+type CreateTagPayload struct {
+	GitRepo
+	CreateTagParams
+}
+
+// CreateReleaseParams params for create release
+type CreateReleaseParams struct {
+	// Tag tag name of the release, created from Ref when not exist
+	Tag string `json:"tag"`
+	// Ref commit sha or branch name used to create the tag
+	Ref string `json:"ref,omitempty"`
+	// Name release title
+	Name string `json:"name"`
+	// Notes release notes
+	Notes string `json:"notes,omitempty"`
+	// Draft create release without publishing it
+	Draft bool `json:"draft"`
+	// Prerelease mark release as not ready for production
+	Prerelease bool `json:"prerelease"`
+}
+
+// CreateReleasePayload payload for create release
+type CreateReleasePayload struct {
+	GitRepo
+	CreateReleaseParams
+}
+
+// BranchProtectionParams params for set branch protection rules
+type BranchProtectionParams struct {
+	// DevelopersCanPush developer can push to this branch
+	DevelopersCanPush *bool `json:"developersCanPush,omitempty"`
+	// DevelopersCanMerge developer can merge to this branch
+	DevelopersCanMerge *bool `json:"developersCanMerge,omitempty"`
+	// RequiredApprovals number of approvals required before merging
+	RequiredApprovals *int `json:"requiredApprovals,omitempty"`
+	// RequiredStatusChecks status check contexts that must pass before merging
+	RequiredStatusChecks []string `json:"requiredStatusChecks,omitempty"`
+}
+
+// BranchProtectionPayload payload for set branch protection rules
+type BranchProtectionPayload struct {
+	GitBranchOption
+	BranchProtectionParams
+}
+
 // CreatePullRequestPayload option for create PullRequest
 type CreatePullRequestPayload struct {
 	Source      GitBranchBaseInfo `json:"source"`
@@ -65,4 +157,96 @@ type CreatePullRequestCommentPayload struct {
 // CreatePullRequestCommentParam param for create pr's comment
 type CreatePullRequestCommentParam struct {
 	Body string `json:"body"`
+	// Position creates an inline review comment when provided
+	// +optional
+	Position *GitPullRequestNotePosition `json:"position,omitempty"`
+}
+
+// UpdatePullRequestCommentPayload payload for update pr's comment
+type UpdatePullRequestCommentPayload struct {
+	GitPullRequestCommentOption
+	UpdatePullRequestCommentParam
+}
+
+// UpdatePullRequestCommentParam param for update pr's comment
+type UpdatePullRequestCommentParam struct {
+	Body string `json:"body"`
+}
+
+// GitPullRequestMergeMethod method used to merge a pull request
+type GitPullRequestMergeMethod string
+
+const (
+	// GitPullRequestMergeMethodMerge create a merge commit
+	GitPullRequestMergeMethodMerge GitPullRequestMergeMethod = "merge"
+	// GitPullRequestMergeMethodSquash squash all commits into one commit
+	GitPullRequestMergeMethodSquash GitPullRequestMergeMethod = "squash"
+	// GitPullRequestMergeMethodRebase rebase commits onto the target branch
+	GitPullRequestMergeMethodRebase GitPullRequestMergeMethod = "rebase"
+)
+
+// MergePullRequestParams params for merge pr
+type MergePullRequestParams struct {
+	// Method merge strategy, the platform default is used when empty
+	Method GitPullRequestMergeMethod `json:"method,omitempty"`
+	// CommitMessage message for the merge or squash commit
+	CommitMessage string `json:"commitMessage,omitempty"`
+}
+
+// MergePullRequestPayload payload for merge pr
+type MergePullRequestPayload struct {
+	GitRepo
+	MergePullRequestParams
+	Index int `json:"index"`
+}
+
+// UpdatePullRequestParams params for update pr, only not nil fields will be updated
+type UpdatePullRequestParams struct {
+	// Title new pr title
+	Title *string `json:"title,omitempty"`
+	// Description new pr description
+	Description *string `json:"description,omitempty"`
+	// TargetBranch new target branch name
+	TargetBranch *string `json:"targetBranch,omitempty"`
+}
+
+// UpdatePullRequestPayload payload for update pr
+type UpdatePullRequestPayload struct {
+	GitRepo
+	UpdatePullRequestParams
+	Index int `json:"index"`
+}
+
+// CreateCommitStatusParams params for create commit status
+type CreateCommitStatusParams struct {
+	// State status state
+	State GitCommitStatusState `json:"state"`
+	// Context label to differentiate this status from others, i.e ci/build
+	Context string `json:"context"`
+	// TargetURL url with details of the status
+	TargetURL string `json:"targetURL,omitempty"`
+	// Description short description of the status
+	Description string `json:"description,omitempty"`
+	// Coverage code coverage for test, not supported by all platforms
+	Coverage *float64 `json:"coverage,omitempty"`
+}
+
+// CreateCommitStatusPayload payload for create commit status
+type CreateCommitStatusPayload struct {
+	GitCommitOption
+	CreateCommitStatusParams
+}
+
+// CreatePullRequestReviewParams params for submit a pr review
+type CreatePullRequestReviewParams struct {
+	// State review state
+	State GitPullRequestReviewState `json:"state"`
+	// Body review summary
+	Body string `json:"body,omitempty"`
+}
+
+// CreatePullRequestReviewPayload payload for submit a pr review
+type CreatePullRequestReviewPayload struct {
+	GitPullRequestOption
+	CreatePullRequestReviewParams
 }
@@ -52,6 +52,10 @@ type GitBranchSpec struct {
 	DevelopersCanPush *bool `json:"developersCanPush,omitempty" yaml:"developersCanPush,omitempty"`
 	// DevelopersCanMerge developer can merge to this branch
 	DevelopersCanMerge *bool `json:"developersCanMerge,omitempty" yaml:"developersCanMerge,omitempty"`
+	// RequiredApprovals number of approvals required before merging to this branch
+	RequiredApprovals *int `json:"requiredApprovals,omitempty" yaml:"requiredApprovals,omitempty"`
+	// RequiredStatusChecks status check contexts that must pass before merging to this branch
+	RequiredStatusChecks []string `json:"requiredStatusChecks,omitempty" yaml:"requiredStatusChecks,omitempty"`
 	// Commit latest commit's sha in this branch
 	Commit     GitCommitBasicInfo    `json:"commit"`
 	Properties *runtime.RawExtension `json:"properties,omitempty"`
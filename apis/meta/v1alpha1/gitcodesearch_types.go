@@ -0,0 +1,63 @@
+/*
+Copyright 2021 The Katanomi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var (
+	GitCodeSearchResultGVK     = GroupVersion.WithKind("GitCodeSearchResult")
+	GitCodeSearchResultListGVK = GroupVersion.WithKind("GitCodeSearchResultList")
+)
+
+// GitCodeSearchResult file matching a code search
+type GitCodeSearchResult struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec GitCodeSearchResultSpec `json:"spec"`
+}
+
+// GitCodeSearchResultSpec spec for code search result
+type GitCodeSearchResultSpec struct {
+	GitRepo
+	// Path file path in repository
+	Path string `json:"path"`
+	// Ref commit/branch name the file was searched in
+	Ref string `json:"ref,omitempty"`
+	// Fragments matched lines of the file
+	Fragments  []GitCodeSearchFragment `json:"fragments,omitempty"`
+	Properties *runtime.RawExtension   `json:"properties,omitempty"`
+}
+
+// GitCodeSearchFragment matched line fragment
+type GitCodeSearchFragment struct {
+	// Line line number of the fragment, 0 when not reported by the platform
+	Line int `json:"line,omitempty"`
+	// Content text of the fragment
+	Content string `json:"content"`
+}
+
+// GitCodeSearchResultList list of code search results
+type GitCodeSearchResultList struct {
+	metav1.TypeMeta `json:",inline"`
+	ListMeta        `json:"metadata,omitempty"`
+
+	Items []GitCodeSearchResult `json:"items"`
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2021 The Katanomi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var (
+	GitCommitStatusGVK     = GroupVersion.WithKind("GitCommitStatus")
+	GitCommitStatusListGVK = GroupVersion.WithKind("GitCommitStatusList")
+)
+
+// GitCommitStatusState state of a commit status
+type GitCommitStatusState string
+
+const (
+	// GitCommitStatusStatePending the check is pending or running
+	GitCommitStatusStatePending GitCommitStatusState = "pending"
+	// GitCommitStatusStateSuccess the check succeeded
+	GitCommitStatusStateSuccess GitCommitStatusState = "success"
+	// GitCommitStatusStateFailure the check failed
+	GitCommitStatusStateFailure GitCommitStatusState = "failure"
+	// GitCommitStatusStateError the check could not be completed
+	GitCommitStatusStateError GitCommitStatusState = "error"
+)
+
+// IsValid returns true if the state is one of the defined commit status states
+func (s GitCommitStatusState) IsValid() bool {
+	switch s {
+	case GitCommitStatusStatePending, GitCommitStatusStateSuccess,
+		GitCommitStatusStateFailure, GitCommitStatusStateError:
+		return true
+	}
+	return false
+}
+
+// GitCommitStatus object for plugin
+type GitCommitStatus struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec GitCommitStatusSpec `json:"spec"`
+}
+
+// GitCommitStatusSpec spec for commit status
+type GitCommitStatusSpec struct {
+	GitCommitBasicInfo
+	// ID status id in platform
+	ID int64 `json:"id"`
+	// State status state
+	State GitCommitStatusState `json:"state"`
+	// Context label to differentiate this status from others, i.e ci/build
+	Context string `json:"context"`
+	// TargetURL url with details of the status
+	TargetURL string `json:"targetURL,omitempty"`
+	// Description short description of the status
+	Description string `json:"description,omitempty"`
+	// Coverage code coverage for test
+	Coverage *float64 `json:"coverage,omitempty"`
+	// Creator status creator
+	Creator *GitUserBaseInfo `json:"creator,omitempty"`
+	// CreatedAt status create time
+	CreatedAt metav1.Time `json:"createdAt"`
+	// UpdatedAt status latest update time
+	UpdatedAt  *metav1.Time          `json:"updatedAt,omitempty"`
+	Properties *runtime.RawExtension `json:"properties,omitempty"`
+}
+
+// GitCommitStatusList list of commit statuses
+type GitCommitStatusList struct {
+	metav1.TypeMeta `json:",inline"`
+	ListMeta        `json:"metadata,omitempty"`
+
+	Items []GitCommitStatus `json:"items"`
+}
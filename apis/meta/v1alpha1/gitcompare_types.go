@@ -0,0 +1,87 @@
+/*
+Copyright 2021 The Katanomi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var (
+	GitCompareGVK         = GroupVersion.WithKind("GitCompare")
+	GitChangedFileListGVK = GroupVersion.WithKind("GitChangedFileList")
+)
+
+// GitChangedFileStatus change status of a file
+type GitChangedFileStatus string
+
+const (
+	// GitChangedFileStatusAdded file is added
+	GitChangedFileStatusAdded GitChangedFileStatus = "added"
+	// GitChangedFileStatusModified file is modified
+	GitChangedFileStatusModified GitChangedFileStatus = "modified"
+	// GitChangedFileStatusRemoved file is removed
+	GitChangedFileStatusRemoved GitChangedFileStatus = "removed"
+	// GitChangedFileStatusRenamed file is renamed
+	GitChangedFileStatusRenamed GitChangedFileStatus = "renamed"
+)
+
+// GitChangedFile a changed file between two revisions
+type GitChangedFile struct {
+	// Path file path after the change
+	Path string `json:"path"`
+	// PreviousPath file path before the change, only set when renamed
+	PreviousPath string `json:"previousPath,omitempty"`
+	// Status change status
+	Status GitChangedFileStatus `json:"status"`
+	// Additions number of added lines
+	Additions int `json:"additions"`
+	// Deletions number of deleted lines
+	Deletions int `json:"deletions"`
+	// Patch unified diff of the file, may be empty for binary or large files
+	Patch *string `json:"patch,omitempty"`
+}
+
+// GitChangedFileList list of changed files
+type GitChangedFileList struct {
+	metav1.TypeMeta `json:",inline"`
+	ListMeta        `json:"metadata,omitempty"`
+
+	Items []GitChangedFile `json:"items"`
+}
+
+// GitCompare object for plugin
+type GitCompare struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec GitCompareSpec `json:"spec"`
+}
+
+// GitCompareSpec spec for compare result
+type GitCompareSpec struct {
+	GitRepo
+	// Base ref used as comparison base
+	Base string `json:"base"`
+	// Head ref compared against base
+	Head string `json:"head"`
+	// Commits commits reachable from head but not from base
+	Commits []GitCommit `json:"commits"`
+	// Files changed files between base and head
+	Files      []GitChangedFile      `json:"files"`
+	Properties *runtime.RawExtension `json:"properties,omitempty"`
+}
@@ -0,0 +1,65 @@
+/*
+Copyright 2021 The Katanomi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+
+	cloudevent "github.com/cloudevents/sdk-go/v2"
+)
+
+// SetGitEventData sets the type and json data of a CloudEvent using a normalized git event payload
+func SetGitEventData(event *cloudevent.Event, payload GitEventPayload) error {
+	if payload == nil {
+		return fmt.Errorf("git event payload is nil")
+	}
+	eventType := payload.GitEventType()
+	if eventType == "" {
+		return fmt.Errorf("git event payload %T has no event type", payload)
+	}
+	event.SetType(eventType)
+	return event.SetData(cloudevent.ApplicationJSON, payload)
+}
+
+// NewGitEventPayload returns an empty payload for a git CloudEvent type
+func NewGitEventPayload(eventType string) (GitEventPayload, error) {
+	switch eventType {
+	case GitPushEventType:
+		return &GitPushEventPayload{}, nil
+	case GitTagPushEventType:
+		return &GitTagPushEventPayload{}, nil
+	case GitPullRequestOpenedEventType, GitPullRequestUpdatedEventType,
+		GitPullRequestMergedEventType, GitPullRequestClosedEventType:
+		return &GitPullRequestEventPayload{}, nil
+	case GitPullRequestCommentEventType:
+		return &GitPullRequestCommentEventPayload{}, nil
+	default:
+		return nil, fmt.Errorf("unsupported git event type %q", eventType)
+	}
+}
+
+// GetGitEventData decodes the data of a git CloudEvent into its normalized payload
+func GetGitEventData(event cloudevent.Event) (GitEventPayload, error) {
+	payload, err := NewGitEventPayload(event.Type())
+	if err != nil {
+		return nil, err
+	}
+	if err = event.DataAs(payload); err != nil {
+		return nil, err
+	}
+	return payload, nil
+}
@@ -0,0 +1,150 @@
+/*
+Copyright 2021 The Katanomi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"testing"
+
+	cloudevent "github.com/cloudevents/sdk-go/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestGitEventData(t *testing.T) {
+	sha := "abc123"
+	repo := GitRepo{Project: "katanomi", Repository: "pkg"}
+	pullRequest := GitPullRequestEventInfo{
+		Number: 1,
+		Title:  "feat: git events",
+		Source: GitBranchBaseInfo{GitRepo: repo, Name: "feature"},
+		Target: GitBranchBaseInfo{GitRepo: repo, Name: "main"},
+		Head:   GitCommitBasicInfo{SHA: &sha},
+	}
+	table := map[string]struct {
+		Payload GitEventPayload
+		Type    string
+	}{
+		"Push": {
+			Payload: &GitPushEventPayload{
+				GitRepo: repo,
+				Branch:  GitBranchBaseInfo{GitRepo: repo, Name: "main"},
+				After:   GitCommitBasicInfo{SHA: &sha},
+				Commits: []GitEventCommit{{GitCommitBasicInfo: GitCommitBasicInfo{SHA: &sha}, Message: "init"}},
+			},
+			Type: GitPushEventType,
+		},
+		"Tag push": {
+			Payload: &GitTagPushEventPayload{GitRepo: repo, Tag: "v0.1.0", Commit: GitCommitBasicInfo{SHA: &sha}},
+			Type:    GitTagPushEventType,
+		},
+		"Pull request opened": {
+			Payload: &GitPullRequestEventPayload{GitRepo: repo, Action: GitPullRequestEventActionOpened, PullRequest: pullRequest},
+			Type:    GitPullRequestOpenedEventType,
+		},
+		"Pull request updated": {
+			Payload: &GitPullRequestEventPayload{GitRepo: repo, Action: GitPullRequestEventActionUpdated, PullRequest: pullRequest},
+			Type:    GitPullRequestUpdatedEventType,
+		},
+		"Pull request merged": {
+			Payload: &GitPullRequestEventPayload{GitRepo: repo, Action: GitPullRequestEventActionMerged, PullRequest: pullRequest},
+			Type:    GitPullRequestMergedEventType,
+		},
+		"Pull request closed": {
+			Payload: &GitPullRequestEventPayload{GitRepo: repo, Action: GitPullRequestEventActionClosed, PullRequest: pullRequest},
+			Type:    GitPullRequestClosedEventType,
+		},
+		"Pull request comment": {
+			Payload: &GitPullRequestCommentEventPayload{
+				GitRepo:     repo,
+				PullRequest: pullRequest,
+				Comment:     GitPullRequestEventComment{ID: 1, Body: "/lgtm"},
+			},
+			Type: GitPullRequestCommentEventType,
+		},
+	}
+
+	for name, item := range table {
+		test := item
+		t.Run(name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+
+			event := cloudevent.NewEvent()
+			g.Expect(SetGitEventData(&event, test.Payload)).To(Succeed())
+			g.Expect(event.Type()).To(Equal(test.Type))
+
+			payload, err := GetGitEventData(event)
+			g.Expect(err).To(BeNil())
+			g.Expect(payload).To(Equal(test.Payload))
+		})
+	}
+}
+
+func TestGetGitEventDataUnknownType(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	event := cloudevent.NewEvent()
+	event.SetType("dev.katanomi.unknown")
+
+	_, err := GetGitEventData(event)
+	g.Expect(err).NotTo(BeNil())
+}
+
+func TestSetGitEventDataInvalidPayload(t *testing.T) {
+	table := map[string]struct {
+		Payload GitEventPayload
+	}{
+		"Nil payload": {
+			Payload: nil,
+		},
+		"Typed nil pull request payload": {
+			Payload: (*GitPullRequestEventPayload)(nil),
+		},
+		"Typed nil push payload": {
+			Payload: (*GitPushEventPayload)(nil),
+		},
+		"Typed nil tag push payload": {
+			Payload: (*GitTagPushEventPayload)(nil),
+		},
+		"Typed nil pull request comment payload": {
+			Payload: (*GitPullRequestCommentEventPayload)(nil),
+		},
+		"Pull request payload without action": {
+			Payload: &GitPullRequestEventPayload{},
+		},
+		"Pull request payload with unknown action": {
+			Payload: &GitPullRequestEventPayload{Action: "reopened"},
+		},
+	}
+
+	for name, item := range table {
+		test := item
+		t.Run(name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+
+			event := cloudevent.NewEvent()
+			g.Expect(SetGitEventData(&event, test.Payload)).NotTo(Succeed())
+		})
+	}
+}
+
+func TestGitEventPayloadNilType(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	g.Expect((*GitPushEventPayload)(nil).GitEventType()).To(BeEmpty())
+	g.Expect((*GitTagPushEventPayload)(nil).GitEventType()).To(BeEmpty())
+	g.Expect((*GitPullRequestEventPayload)(nil).GitEventType()).To(BeEmpty())
+	g.Expect((*GitPullRequestCommentEventPayload)(nil).GitEventType()).To(BeEmpty())
+}
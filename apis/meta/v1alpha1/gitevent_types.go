@@ -0,0 +1,192 @@
+/*
+Copyright 2021 The Katanomi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+// Well-known CloudEvent types for normalized git events
+const (
+	// GitPushEventType event type for branch push
+	GitPushEventType = "dev.katanomi.cloudevents.git.push"
+	// GitTagPushEventType event type for tag push
+	GitTagPushEventType = "dev.katanomi.cloudevents.git.tag_push"
+	// GitPullRequestOpenedEventType event type for pull request opened
+	GitPullRequestOpenedEventType = "dev.katanomi.cloudevents.git.pull_request.opened"
+	// GitPullRequestUpdatedEventType event type for pull request updated
+	GitPullRequestUpdatedEventType = "dev.katanomi.cloudevents.git.pull_request.updated"
+	// GitPullRequestMergedEventType event type for pull request merged
+	GitPullRequestMergedEventType = "dev.katanomi.cloudevents.git.pull_request.merged"
+	// GitPullRequestClosedEventType event type for pull request closed
+	GitPullRequestClosedEventType = "dev.katanomi.cloudevents.git.pull_request.closed"
+	// GitPullRequestCommentEventType event type for pull request comment
+	GitPullRequestCommentEventType = "dev.katanomi.cloudevents.git.pull_request.comment"
+)
+
+// GitPullRequestEventAction action of a pull request event
+type GitPullRequestEventAction string
+
+const (
+	// GitPullRequestEventActionOpened pull request opened
+	GitPullRequestEventActionOpened GitPullRequestEventAction = "opened"
+	// GitPullRequestEventActionUpdated pull request updated
+	GitPullRequestEventActionUpdated GitPullRequestEventAction = "updated"
+	// GitPullRequestEventActionMerged pull request merged
+	GitPullRequestEventActionMerged GitPullRequestEventAction = "merged"
+	// GitPullRequestEventActionClosed pull request closed
+	GitPullRequestEventActionClosed GitPullRequestEventAction = "closed"
+)
+
+// GitEventPayload payload of a normalized git event
+type GitEventPayload interface {
+	// GitEventType returns the CloudEvent type of the payload,
+	// empty if the payload is nil or its type cannot be determined
+	GitEventType() string
+}
+
+// GitEventCommit commit info carried by git events
+type GitEventCommit struct {
+	GitCommitBasicInfo
+	// Message commit message
+	Message string `json:"message,omitempty"`
+	// Author commit author
+	Author *GitUserBaseInfo `json:"author,omitempty"`
+	// Timestamp commit time
+	Timestamp *metav1.Time `json:"timestamp,omitempty"`
+}
+
+// GitPushEventPayload payload for branch push events
+type GitPushEventPayload struct {
+	GitRepo
+	// Branch pushed branch
+	Branch GitBranchBaseInfo `json:"branch"`
+	// Before commit of the branch before the push
+	Before GitCommitBasicInfo `json:"before"`
+	// After commit of the branch after the push
+	After GitCommitBasicInfo `json:"after"`
+	// Commits pushed commits
+	Commits []GitEventCommit `json:"commits,omitempty"`
+	// Sender user who pushed
+	Sender GitUserBaseInfo `json:"sender"`
+}
+
+// GitEventType returns the CloudEvent type of the payload
+func (p *GitPushEventPayload) GitEventType() string {
+	if p == nil {
+		return ""
+	}
+	return GitPushEventType
+}
+
+// GitTagPushEventPayload payload for tag push events
+type GitTagPushEventPayload struct {
+	GitRepo
+	// Tag pushed tag name
+	Tag string `json:"tag"`
+	// Commit commit the tag points to
+	Commit GitCommitBasicInfo `json:"commit"`
+	// Sender user who pushed
+	Sender GitUserBaseInfo `json:"sender"`
+}
+
+// GitEventType returns the CloudEvent type of the payload
+func (p *GitTagPushEventPayload) GitEventType() string {
+	if p == nil {
+		return ""
+	}
+	return GitTagPushEventType
+}
+
+// GitPullRequestEventInfo pull request info carried by git events
+type GitPullRequestEventInfo struct {
+	// ID num for pr in platform
+	ID int64 `json:"id"`
+	// Number num for pr in repo
+	Number int64 `json:"num"`
+	// Title pr title
+	Title string `json:"title"`
+	// Source pr source branch and repo
+	Source GitBranchBaseInfo `json:"source"`
+	// Target pr target branch and repo
+	Target GitBranchBaseInfo `json:"target"`
+	// Head latest commit of the source branch
+	Head GitCommitBasicInfo `json:"head"`
+	// Author pr author
+	Author GitUserBaseInfo `json:"author"`
+	// MergeCommit commit created by merging the pr, only set when merged
+	// +optional
+	MergeCommit *GitCommitBasicInfo `json:"mergeCommit,omitempty"`
+}
+
+// GitPullRequestEventPayload payload for pull request opened/updated/merged/closed events
+type GitPullRequestEventPayload struct {
+	GitRepo
+	// Action what happened to the pull request
+	Action GitPullRequestEventAction `json:"action"`
+	// PullRequest pull request the event is about
+	PullRequest GitPullRequestEventInfo `json:"pullRequest"`
+	// Sender user who triggered the event
+	Sender GitUserBaseInfo `json:"sender"`
+}
+
+// GitEventType returns the CloudEvent type of the payload according to its action
+// returns an empty string for a nil payload or an unknown action
+func (p *GitPullRequestEventPayload) GitEventType() string {
+	if p == nil {
+		return ""
+	}
+	switch p.Action {
+	case GitPullRequestEventActionOpened:
+		return GitPullRequestOpenedEventType
+	case GitPullRequestEventActionUpdated:
+		return GitPullRequestUpdatedEventType
+	case GitPullRequestEventActionMerged:
+		return GitPullRequestMergedEventType
+	case GitPullRequestEventActionClosed:
+		return GitPullRequestClosedEventType
+	default:
+		return ""
+	}
+}
+
+// GitPullRequestEventComment comment info carried by git events
+type GitPullRequestEventComment struct {
+	// ID comment id
+	ID int `json:"id"`
+	// Body comment content
+	Body string `json:"body"`
+	// Author comment author
+	Author GitUserBaseInfo `json:"author"`
+}
+
+// GitPullRequestCommentEventPayload payload for pull request comment events
+type GitPullRequestCommentEventPayload struct {
+	GitRepo
+	// PullRequest pull request the comment belongs to
+	PullRequest GitPullRequestEventInfo `json:"pullRequest"`
+	// Comment created comment
+	Comment GitPullRequestEventComment `json:"comment"`
+	// Sender user who commented
+	Sender GitUserBaseInfo `json:"sender"`
+}
+
+// GitEventType returns the CloudEvent type of the payload
+func (p *GitPullRequestCommentEventPayload) GitEventType() string {
+	if p == nil {
+		return ""
+	}
+	return GitPullRequestCommentEventType
+}
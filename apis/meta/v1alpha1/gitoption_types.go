@@ -16,6 +16,8 @@ limitations under the License.
 
 package v1alpha1
 
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
 // GitRepoFileOption option for get repo's file
 type GitRepoFileOption struct {
 	GitRepo
@@ -24,14 +26,92 @@ type GitRepoFileOption struct {
 	Path string `json:"path"`
 }
 
+// GitBranchOption option for one branch by name
+type GitBranchOption struct {
+	GitRepo
+	// Branch branch name
+	Branch string `json:"branch"`
+}
+
 // GitCommitOption option for get one commit by sha
 type GitCommitOption struct {
 	GitRepo
 	GitCommitBasicInfo
 }
 
+// GitCommitListOption option for list commits
+type GitCommitListOption struct {
+	GitRepo
+	// Ref commit/branch/tag name, use default branch when empty
+	Ref string `json:"ref,omitempty"`
+	// Path only commits containing this file path will be returned
+	Path string `json:"path,omitempty"`
+	// Author only commits of this author (name or email) will be returned
+	Author string `json:"author,omitempty"`
+	// Since only commits after or on this date will be returned
+	Since *metav1.Time `json:"since,omitempty"`
+	// Until only commits before or on this date will be returned
+	Until *metav1.Time `json:"until,omitempty"`
+}
+
 // GitPullRequestOption option for one pr by id
 type GitPullRequestOption struct {
 	GitRepo
 	Index int `json:"Index"`
 }
+
+// GitPullRequestCommentOption option for one pr comment by id
+type GitPullRequestCommentOption struct {
+	GitPullRequestOption
+	CommentID int `json:"commentID"`
+}
+
+// GitRepoTreeOption option for list repository tree entries
+type GitRepoTreeOption struct {
+	GitRepo
+	// Ref commit/branch/tag name
+	Ref string `json:"ref"`
+	// Path directory path, root directory when empty
+	Path string `json:"path"`
+	// Recursive list all entries under the path recursively
+	Recursive bool `json:"recursive"`
+}
+
+// GitCompareOption option for compare two refs
+type GitCompareOption struct {
+	GitRepo
+	// Base commit/branch/tag name used as comparison base
+	Base string `json:"base"`
+	// Head commit/branch/tag name compared against base
+	Head string `json:"head"`
+}
+
+// GitReleaseOption option for one release
+type GitReleaseOption struct {
+	GitRepo
+	// Release release id
+	Release string `json:"release"`
+}
+
+// GitReleaseAssetOption option for upload release asset
+type GitReleaseAssetOption struct {
+	GitReleaseOption
+	// Name asset file name
+	Name string `json:"name"`
+	// ContentType media type of the asset, application/octet-stream when empty
+	ContentType string `json:"contentType"`
+	// Size asset size in bytes, -1 when unknown
+	Size int64 `json:"size"`
+}
+
+// GitCodeSearchOption option for search code
+type GitCodeSearchOption struct {
+	// GitRepo limits the search to a project or repository when not empty
+	GitRepo
+	// Query text to search
+	Query string `json:"query"`
+	// Path limits the search to files under the path
+	Path string `json:"path,omitempty"`
+	// Language limits the search to files of the language
+	Language string `json:"language,omitempty"`
+}
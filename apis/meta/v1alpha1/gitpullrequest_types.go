@@ -22,9 +22,10 @@ import (
 )
 
 var (
-	GitPullRequestsGVK     = GroupVersion.WithKind("GitPullRequest")
-	GitPullrequestsListGVK = GroupVersion.WithKind("GitPullRequestList")
-	GitPullRequestNotesGVK = GroupVersion.WithKind("GitPullRequestNote")
+	GitPullRequestsGVK        = GroupVersion.WithKind("GitPullRequest")
+	GitPullrequestsListGVK    = GroupVersion.WithKind("GitPullRequestList")
+	GitPullRequestNotesGVK    = GroupVersion.WithKind("GitPullRequestNote")
+	GitPullRequestNoteListGVK = GroupVersion.WithKind("GitPullRequestNoteList")
 )
 
 // GitPullRequest object for plugins
@@ -59,8 +60,41 @@ type GitPullRequestSpec struct {
 	// Author pr author
 	Author GitUserBaseInfo `json:"author,omitempty"`
 	// MergeLog pr merge info(user and time)
-	MergeLog   *GitOperateLogBaseInfo `json:"mergeLog,omitempty"`
-	Properties *runtime.RawExtension  `json:"properties,omitempty"`
+	MergeLog *GitOperateLogBaseInfo `json:"mergeLog,omitempty"`
+	// Mergeability summary of whether the pr can be merged
+	// +optional
+	Mergeability *GitPullRequestMergeability `json:"mergeability,omitempty"`
+	Properties   *runtime.RawExtension       `json:"properties,omitempty"`
+}
+
+// GitPullRequestMergeability mergeability summary of a pr
+type GitPullRequestMergeability struct {
+	// HasConflicts source branch has conflicts with target branch
+	HasConflicts bool `json:"hasConflicts"`
+	// Approvals number of approvals the pr received
+	Approvals int `json:"approvals"`
+	// RequiredApprovals number of approvals required by the target branch
+	RequiredApprovals int `json:"requiredApprovals"`
+	// ChecksPassing all required status checks passed, nil when the platform does not report checks
+	// +optional
+	ChecksPassing *bool `json:"checksPassing,omitempty"`
+}
+
+// ApprovalsMet returns true if the pr received the required number of approvals
+func (m *GitPullRequestMergeability) ApprovalsMet() bool {
+	if m == nil {
+		return false
+	}
+	return m.Approvals >= m.RequiredApprovals
+}
+
+// IsMergeable returns true if the pr has no conflicts, required approvals are met
+// and checks are passing or not reported
+func (m *GitPullRequestMergeability) IsMergeable() bool {
+	if m == nil {
+		return false
+	}
+	return !m.HasConflicts && m.ApprovalsMet() && (m.ChecksPassing == nil || *m.ChecksPassing)
 }
 
 // GitPullRequestList list of pr
@@ -84,6 +118,34 @@ type GitPullRequestNoteSpec struct {
 	// ID note id
 	ID int `json:"id"`
 	// Body note content
-	Body       string                `json:"body"`
-	Properties *runtime.RawExtension `json:"properties,omitempty"`
+	Body string `json:"body"`
+	// Author note author
+	Author *GitUserBaseInfo `json:"author,omitempty"`
+	// CreatedAt note create time
+	CreatedAt *metav1.Time `json:"createdAt,omitempty"`
+	// UpdatedAt note latest update time
+	UpdatedAt *metav1.Time `json:"updatedAt,omitempty"`
+	// Position file and line of an inline review note, nil for a general note
+	Position   *GitPullRequestNotePosition `json:"position,omitempty"`
+	Properties *runtime.RawExtension       `json:"properties,omitempty"`
+}
+
+// GitPullRequestNotePosition position of an inline review note
+type GitPullRequestNotePosition struct {
+	// Path file path the note belongs to
+	Path string `json:"path"`
+	// Line line number in the new version of the file
+	Line int `json:"line,omitempty"`
+	// OldLine line number in the old version of the file, used for removed lines
+	OldLine int `json:"oldLine,omitempty"`
+	// CommitSHA commit the note was made against
+	CommitSHA string `json:"commitSHA,omitempty"`
+}
+
+// GitPullRequestNoteList list of pr notes
+type GitPullRequestNoteList struct {
+	metav1.TypeMeta `json:",inline"`
+	ListMeta        `json:"metadata,omitempty"`
+
+	Items []GitPullRequestNote `json:"items"`
 }
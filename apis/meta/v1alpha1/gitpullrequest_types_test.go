@@ -0,0 +1,98 @@
+/*
+Copyright 2021 The Katanomi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestGitPullRequestMergeabilityIsMergeable(t *testing.T) {
+	trueValue, falseValue := true, false
+	table := map[string]struct {
+		Mergeability *GitPullRequestMergeability
+		Result       bool
+	}{
+		"Nil mergeability": {
+			Mergeability: nil,
+			Result:       false,
+		},
+		"Approved without checks": {
+			Mergeability: &GitPullRequestMergeability{Approvals: 1, RequiredApprovals: 1},
+			Result:       true,
+		},
+		"Approved with passing checks": {
+			Mergeability: &GitPullRequestMergeability{Approvals: 2, RequiredApprovals: 1, ChecksPassing: &trueValue},
+			Result:       true,
+		},
+		"Approved with failing checks": {
+			Mergeability: &GitPullRequestMergeability{Approvals: 1, RequiredApprovals: 1, ChecksPassing: &falseValue},
+			Result:       false,
+		},
+		"Missing approvals": {
+			Mergeability: &GitPullRequestMergeability{Approvals: 1, RequiredApprovals: 2},
+			Result:       false,
+		},
+		"Has conflicts": {
+			Mergeability: &GitPullRequestMergeability{HasConflicts: true},
+			Result:       false,
+		},
+	}
+
+	for name, item := range table {
+		test := item
+		t.Run(name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+
+			g.Expect(test.Mergeability.IsMergeable()).To(Equal(test.Result))
+		})
+	}
+}
+
+func TestGitPullRequestMergeabilityApprovalsMet(t *testing.T) {
+	table := map[string]struct {
+		Mergeability *GitPullRequestMergeability
+		Result       bool
+	}{
+		"Nil mergeability": {
+			Mergeability: nil,
+			Result:       false,
+		},
+		"No approvals required": {
+			Mergeability: &GitPullRequestMergeability{},
+			Result:       true,
+		},
+		"Approvals met": {
+			Mergeability: &GitPullRequestMergeability{Approvals: 2, RequiredApprovals: 2},
+			Result:       true,
+		},
+		"Missing approvals": {
+			Mergeability: &GitPullRequestMergeability{Approvals: 1, RequiredApprovals: 2},
+			Result:       false,
+		},
+	}
+
+	for name, item := range table {
+		test := item
+		t.Run(name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+
+			g.Expect(test.Mergeability.ApprovalsMet()).To(Equal(test.Result))
+		})
+	}
+}
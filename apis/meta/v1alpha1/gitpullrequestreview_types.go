@@ -0,0 +1,72 @@
+/*
+Copyright 2021 The Katanomi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var (
+	GitPullRequestReviewGVK     = GroupVersion.WithKind("GitPullRequestReview")
+	GitPullRequestReviewListGVK = GroupVersion.WithKind("GitPullRequestReviewList")
+)
+
+// GitPullRequestReviewState state of a pr review
+type GitPullRequestReviewState string
+
+const (
+	// GitPullRequestReviewStateApproved reviewer approved the pr
+	GitPullRequestReviewStateApproved GitPullRequestReviewState = "approved"
+	// GitPullRequestReviewStateChangesRequested reviewer requested changes
+	GitPullRequestReviewStateChangesRequested GitPullRequestReviewState = "changes_requested"
+	// GitPullRequestReviewStateCommented reviewer only left comments
+	GitPullRequestReviewStateCommented GitPullRequestReviewState = "commented"
+)
+
+// GitPullRequestReview review for pr
+type GitPullRequestReview struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec GitPullRequestReviewSpec `json:"spec"`
+}
+
+// GitPullRequestReviewSpec review's spec for pr
+type GitPullRequestReviewSpec struct {
+	// ID review id
+	ID int64 `json:"id"`
+	// Reviewer review author
+	Reviewer GitUserBaseInfo `json:"reviewer"`
+	// State review state
+	State GitPullRequestReviewState `json:"state"`
+	// Body review summary
+	Body string `json:"body,omitempty"`
+	// CommitSHA commit the review was made against
+	CommitSHA string `json:"commitSHA,omitempty"`
+	// SubmittedAt review submit time
+	SubmittedAt *metav1.Time          `json:"submittedAt,omitempty"`
+	Properties  *runtime.RawExtension `json:"properties,omitempty"`
+}
+
+// GitPullRequestReviewList list of pr reviews
+type GitPullRequestReviewList struct {
+	metav1.TypeMeta `json:",inline"`
+	ListMeta        `json:"metadata,omitempty"`
+
+	Items []GitPullRequestReview `json:"items"`
+}
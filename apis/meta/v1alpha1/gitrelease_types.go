@@ -0,0 +1,92 @@
+/*
+Copyright 2021 The Katanomi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var (
+	GitReleaseGVK      = GroupVersion.WithKind("GitRelease")
+	GitReleaseListGVK  = GroupVersion.WithKind("GitReleaseList")
+	GitReleaseAssetGVK = GroupVersion.WithKind("GitReleaseAsset")
+)
+
+// GitRelease object for plugins
+type GitRelease struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec GitReleaseSpec `json:"spec"`
+}
+
+// GitReleaseSpec spec for release
+type GitReleaseSpec struct {
+	GitRepo
+	// ID release id in platform
+	ID string `json:"id"`
+	// Tag tag name of the release
+	Tag string `json:"tag"`
+	// Name release title
+	Name string `json:"name"`
+	// Notes release notes
+	Notes string `json:"notes,omitempty"`
+	// Draft release is not published yet
+	Draft bool `json:"draft"`
+	// Prerelease release is not ready for production
+	Prerelease bool `json:"prerelease"`
+	// Author release author
+	Author *GitUserBaseInfo `json:"author,omitempty"`
+	// CreatedAt release create time
+	CreatedAt *metav1.Time `json:"createdAt,omitempty"`
+	// PublishedAt release publish time
+	PublishedAt *metav1.Time `json:"publishedAt,omitempty"`
+	// Assets files attached to the release
+	Assets     []GitReleaseAssetSpec `json:"assets,omitempty"`
+	Properties *runtime.RawExtension `json:"properties,omitempty"`
+}
+
+// GitReleaseList list of releases
+type GitReleaseList struct {
+	metav1.TypeMeta `json:",inline"`
+	ListMeta        `json:"metadata,omitempty"`
+
+	Items []GitRelease `json:"items"`
+}
+
+// GitReleaseAsset file attached to a release
+type GitReleaseAsset struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec GitReleaseAssetSpec `json:"spec"`
+}
+
+// GitReleaseAssetSpec spec for release asset
+type GitReleaseAssetSpec struct {
+	// ID asset id in platform
+	ID string `json:"id"`
+	// Name asset file name
+	Name string `json:"name"`
+	// ContentType media type of the asset
+	ContentType string `json:"contentType,omitempty"`
+	// Size asset size in bytes
+	Size int64 `json:"size"`
+	// DownloadURL url to download the asset
+	DownloadURL string `json:"downloadURL,omitempty"`
+}
@@ -0,0 +1,74 @@
+/*
+Copyright 2021 The Katanomi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var (
+	GitRepoTreeEntryGVK     = GroupVersion.WithKind("GitRepositoryTreeEntry")
+	GitRepoTreeEntryListGVK = GroupVersion.WithKind("GitRepositoryTreeEntryList")
+)
+
+// GitRepoTreeEntryType type of tree entry
+type GitRepoTreeEntryType string
+
+const (
+	// GitRepoTreeEntryTypeFile regular file
+	GitRepoTreeEntryTypeFile GitRepoTreeEntryType = "file"
+	// GitRepoTreeEntryTypeDir directory
+	GitRepoTreeEntryTypeDir GitRepoTreeEntryType = "dir"
+	// GitRepoTreeEntryTypeSubmodule git submodule
+	GitRepoTreeEntryTypeSubmodule GitRepoTreeEntryType = "submodule"
+	// GitRepoTreeEntryTypeSymlink symbolic link
+	GitRepoTreeEntryTypeSymlink GitRepoTreeEntryType = "symlink"
+)
+
+// GitRepoTreeEntry object for plugins
+type GitRepoTreeEntry struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec GitRepoTreeEntrySpec `json:"spec"`
+}
+
+// GitRepoTreeEntrySpec spec for repository's tree entry
+type GitRepoTreeEntrySpec struct {
+	// SHA blob or tree object sha
+	SHA string `json:"sha"`
+	// Name entry name
+	Name string `json:"name"`
+	// Path entry full path in repository
+	Path string `json:"path"`
+	// Type entry type
+	Type GitRepoTreeEntryType `json:"type"`
+	// Size file size, only available for files
+	Size int64 `json:"size,omitempty"`
+	// Mode file mode
+	Mode       string                `json:"mode,omitempty"`
+	Properties *runtime.RawExtension `json:"properties,omitempty"`
+}
+
+// GitRepoTreeEntryList list of tree entries
+type GitRepoTreeEntryList struct {
+	metav1.TypeMeta `json:",inline"`
+	ListMeta        `json:"metadata,omitempty"`
+
+	Items []GitRepoTreeEntry `json:"items"`
+}
@@ -0,0 +1,57 @@
+/*
+Copyright 2021 The Katanomi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var (
+	GitTagGVK     = GroupVersion.WithKind("GitTag")
+	GitTagListGVK = GroupVersion.WithKind("GitTagList")
+)
+
+// GitTag object for plugin
+type GitTag struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec GitTagSpec `json:"spec"`
+}
+
+// GitTagSpec spec of tag
+type GitTagSpec struct {
+	GitRepo
+	// Name tag name
+	Name string `json:"name"`
+	// Message annotated tag message, empty for lightweight tag
+	Message *string `json:"message,omitempty"`
+	// Tagger annotated tag creator
+	Tagger *GitUserBaseInfo `json:"tagger,omitempty"`
+	// Commit commit's sha which the tag point to
+	Commit     GitCommitBasicInfo    `json:"commit"`
+	Properties *runtime.RawExtension `json:"properties,omitempty"`
+}
+
+// GitTagList list of tag
+type GitTagList struct {
+	metav1.TypeMeta `json:",inline"`
+	ListMeta        `json:"metadata,omitempty"`
+
+	Items []GitTag `json:"items"`
+}
@@ -0,0 +1,146 @@
+/*
+Copyright 2021 The Katanomi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var (
+	IssueGVK        = GroupVersion.WithKind("Issue")
+	IssueListGVK    = GroupVersion.WithKind("IssueList")
+	IssueCommentGVK = GroupVersion.WithKind("IssueComment")
+)
+
+// IssueState state of an issue
+type IssueState string
+
+const (
+	// IssueStateOpen issue is open
+	IssueStateOpen IssueState = "open"
+	// IssueStateClosed issue is closed
+	IssueStateClosed IssueState = "closed"
+)
+
+// Issue object for plugins
+type Issue struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec IssueSpec `json:"spec"`
+}
+
+// IssueSpec spec for issue
+type IssueSpec struct {
+	// Project issue belongs to
+	Project string `json:"project"`
+	// Repository issue belongs to, empty for trackers without repositories
+	Repository string `json:"repository,omitempty"`
+	// Key issue identifier in the tool, e.g number in gitea or PROJ-1 in jira
+	Key string `json:"key"`
+	// Title issue title
+	Title string `json:"title"`
+	// Body issue description
+	Body string `json:"body,omitempty"`
+	// State issue state
+	State IssueState `json:"state"`
+	// Labels issue labels
+	Labels []string `json:"labels,omitempty"`
+	// Author issue author
+	Author GitUserBaseInfo `json:"author"`
+	// Assignees issue assignees
+	Assignees []GitUserBaseInfo `json:"assignees,omitempty"`
+	// CreatedAt issue create time
+	CreatedAt metav1.Time `json:"createdAt"`
+	// UpdatedAt issue latest update time
+	UpdatedAt *metav1.Time `json:"updatedAt,omitempty"`
+	// ClosedAt issue close time
+	ClosedAt *metav1.Time `json:"closedAt,omitempty"`
+	// Properties extended properties of the issue in the tool
+	Properties *runtime.RawExtension `json:"properties,omitempty"`
+}
+
+// IssueList list of issues
+type IssueList struct {
+	metav1.TypeMeta `json:",inline"`
+	ListMeta        `json:"metadata,omitempty"`
+
+	Items []Issue `json:"items"`
+}
+
+// IssueComment comment of an issue
+type IssueComment struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec IssueCommentSpec `json:"spec"`
+}
+
+// IssueCommentSpec spec for issue comment
+type IssueCommentSpec struct {
+	// ID comment id
+	ID string `json:"id"`
+	// Body comment content
+	Body string `json:"body"`
+	// Author comment author
+	Author *GitUserBaseInfo `json:"author,omitempty"`
+	// CreatedAt comment create time
+	CreatedAt *metav1.Time `json:"createdAt,omitempty"`
+}
+
+// IssueOptions path params to locate issues
+type IssueOptions struct {
+	// Project issue belongs to
+	Project string `json:"project"`
+	// Repository issue belongs to, empty for trackers without repositories
+	Repository string `json:"repository,omitempty"`
+	// Issue key of the issue, empty when listing or creating
+	Issue string `json:"issue,omitempty"`
+}
+
+// CreateIssueParams params for create issue
+type CreateIssueParams struct {
+	// Title issue title
+	Title string `json:"title"`
+	// Body issue description
+	Body string `json:"body,omitempty"`
+	// Labels issue labels
+	Labels []string `json:"labels,omitempty"`
+	// Assignees login names of issue assignees
+	Assignees []string `json:"assignees,omitempty"`
+}
+
+// UpdateIssueParams params for update issue, nil fields are not changed
+type UpdateIssueParams struct {
+	// Title issue title
+	Title *string `json:"title,omitempty"`
+	// Body issue description
+	Body *string `json:"body,omitempty"`
+	// State issue state, used to close or reopen the issue
+	State *IssueState `json:"state,omitempty"`
+	// Labels replaces issue labels when not nil, empty list removes all labels
+	Labels []string `json:"labels"`
+	// Assignees replaces issue assignees when not nil, empty list removes all assignees
+	Assignees []string `json:"assignees"`
+}
+
+// CreateIssueCommentParams params for create issue comment
+type CreateIssueCommentParams struct {
+	// Body comment content
+	Body string `json:"body"`
+}
@@ -57,3 +57,12 @@ type ArtifactOptions struct {
 	// artifact name
 	Artifact string `json:"artifact"`
 }
+
+// ResourceOptions path params to identify a resource
+type ResourceOptions struct {
+	// SubType of the resource
+	SubType ResourceSubType `json:"subType"`
+
+	// ID identifier of the resource in the tool
+	ID string `json:"id"`
+}
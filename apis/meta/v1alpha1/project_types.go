@@ -18,6 +18,7 @@ package v1alpha1
 
 import (
 	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
@@ -25,6 +26,8 @@ import (
 
 var ProjectGVK = GroupVersion.WithKind("Project")
 var ProjectListGVK = GroupVersion.WithKind("ProjectList")
+var ProjectMemberGVK = GroupVersion.WithKind("ProjectMember")
+var ProjectMemberListGVK = GroupVersion.WithKind("ProjectMemberList")
 
 // Project object for plugins
 type Project struct {
@@ -40,6 +43,10 @@ type ProjectSpec struct {
 	// Public defines if a project is public or not
 	Public bool `json:"public"`
 
+	// Description of the project
+	// +optional
+	Description string `json:"description,omitempty"`
+
 	// Address API related access URL
 	// +optional
 	Address *duckv1.Addressable `json:"address,omitempty"`
@@ -67,3 +74,32 @@ type ProjectList struct {
 
 	Items []Project `json:"items"`
 }
+
+// ProjectMember user or group member of a project
+type ProjectMember struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ProjectMemberSpec `json:"spec"`
+}
+
+// ProjectMemberSpec spec for project member
+type ProjectMemberSpec struct {
+	// Subject user or group of the member in the tool.
+	// Kind is one of User or Group
+	Subject rbacv1.Subject `json:"subject"`
+
+	// Role of the member in the project, different between tools
+	Role string `json:"role"`
+
+	// Properties extended properties for ProjectMember
+	Properties *runtime.RawExtension `json:"properties,omitempty"`
+}
+
+// ProjectMemberList list of project members
+type ProjectMemberList struct {
+	metav1.TypeMeta `json:",inline"`
+	ListMeta        `json:"metadata,omitempty"`
+
+	Items []ProjectMember `json:"items"`
+}
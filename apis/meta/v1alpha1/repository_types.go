@@ -63,3 +63,33 @@ type RepositoryList struct {
 
 	Items []Repository `json:"items"`
 }
+
+// RepositoryVisibility visibility of a repository
+type RepositoryVisibility string
+
+const (
+	// RepositoryVisibilityPublic repository is visible to everyone
+	RepositoryVisibilityPublic RepositoryVisibility = "public"
+	// RepositoryVisibilityInternal repository is visible to authenticated users
+	RepositoryVisibilityInternal RepositoryVisibility = "internal"
+	// RepositoryVisibilityPrivate repository is visible to members only
+	RepositoryVisibilityPrivate RepositoryVisibility = "private"
+)
+
+// RepositoryCreateOptions options to create a repository
+type RepositoryCreateOptions struct {
+	// Name of the repository
+	Name string `json:"name"`
+
+	// Visibility of the repository, tool default is used when empty
+	// +optional
+	Visibility RepositoryVisibility `json:"visibility,omitempty"`
+
+	// Description of the repository
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// DefaultBranch initial default branch, only used by code repositories
+	// +optional
+	DefaultBranch string `json:"defaultBranch,omitempty"`
+}
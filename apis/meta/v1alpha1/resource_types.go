@@ -29,6 +29,12 @@ const (
 	ResourceSubTypeImageRegistry ResourceSubType = "ImageRegistry"
 	// Code repository project
 	ResourceSubTypeCodeRepository ResourceSubType = "CodeRepository"
+	// Repository inside an OCI artifact registry project
+	ResourceSubTypeImageRepository ResourceSubType = "ImageRepository"
+	// Artifact inside an OCI artifact repository
+	ResourceSubTypeArtifact ResourceSubType = "Artifact"
+	// Git repository inside a code repository project
+	ResourceSubTypeGitRepository ResourceSubType = "GitRepository"
 )
 
 var ResourceGVK = GroupVersion.WithKind("Resource")
@@ -62,6 +68,10 @@ type ResourceSpec struct {
 	// +optional
 	Version string `json:"version,omitempty"`
 
+	// HasChildren is true when the resource can be browsed for children
+	// +optional
+	HasChildren bool `json:"hasChildren,omitempty"`
+
 	// Properties extended properties for Resource
 	// +optional
 	Properties *runtime.RawExtension `json:"properties,omitempty"`
@@ -0,0 +1,142 @@
+/*
+Copyright 2021 The Katanomi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var ScanReportGVK = GroupVersion.WithKind("ScanReport")
+
+// ScanStatus status of an artifact scan
+type ScanStatus string
+
+const (
+	// ScanStatusQueued scan is waiting to be executed
+	ScanStatusQueued ScanStatus = "queued"
+	// ScanStatusRunning scan is running
+	ScanStatusRunning ScanStatus = "running"
+	// ScanStatusFinished scan finished and the report is available
+	ScanStatusFinished ScanStatus = "finished"
+	// ScanStatusFailed scan failed
+	ScanStatusFailed ScanStatus = "failed"
+)
+
+// VulnerabilitySeverity severity of a vulnerability
+type VulnerabilitySeverity string
+
+const (
+	// VulnerabilitySeverityCritical critical severity vulnerability
+	VulnerabilitySeverityCritical VulnerabilitySeverity = "critical"
+	// VulnerabilitySeverityHigh high severity vulnerability
+	VulnerabilitySeverityHigh VulnerabilitySeverity = "high"
+	// VulnerabilitySeverityMedium medium severity vulnerability
+	VulnerabilitySeverityMedium VulnerabilitySeverity = "medium"
+	// VulnerabilitySeverityLow low severity vulnerability
+	VulnerabilitySeverityLow VulnerabilitySeverity = "low"
+	// VulnerabilitySeverityUnknown severity not reported by the scanner
+	VulnerabilitySeverityUnknown VulnerabilitySeverity = "unknown"
+)
+
+// ScanReport scan report of an artifact
+type ScanReport struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ScanReportSpec `json:"spec"`
+}
+
+// ScanReportSpec spec for scan report
+type ScanReportSpec struct {
+	// Status of the latest scan
+	Status ScanStatus `json:"status"`
+
+	// Scanner name of the scanner used
+	// +optional
+	Scanner string `json:"scanner,omitempty"`
+
+	// StartedAt time the scan started
+	// +optional
+	StartedAt *metav1.Time `json:"startedAt,omitempty"`
+
+	// FinishedAt time the scan finished
+	// +optional
+	FinishedAt *metav1.Time `json:"finishedAt,omitempty"`
+
+	// Summary vulnerability summary, only available when the scan finished
+	// +optional
+	Summary *VulnerabilitySummary `json:"summary,omitempty"`
+
+	// Vulnerabilities detailed findings, only returned when requested
+	// +optional
+	Vulnerabilities []Vulnerability `json:"vulnerabilities,omitempty"`
+
+	// Properties extended properties for ScanReport
+	// +optional
+	Properties *runtime.RawExtension `json:"properties,omitempty"`
+}
+
+// VulnerabilitySummary number of vulnerabilities by severity
+type VulnerabilitySummary struct {
+	// Total number of vulnerabilities
+	Total int `json:"total"`
+
+	// Fixable number of vulnerabilities with a fix version
+	// +optional
+	Fixable int `json:"fixable"`
+
+	Critical int `json:"critical"`
+	High     int `json:"high"`
+	Medium   int `json:"medium"`
+	Low      int `json:"low"`
+	Unknown  int `json:"unknown"`
+}
+
+// Vulnerability a vulnerability found by the scanner
+type Vulnerability struct {
+	// ID of the vulnerability, e.g CVE-2021-44228
+	ID string `json:"id"`
+
+	// Severity of the vulnerability
+	Severity VulnerabilitySeverity `json:"severity"`
+
+	// Package affected by the vulnerability
+	Package string `json:"package"`
+
+	// Version of the package installed in the artifact
+	Version string `json:"version"`
+
+	// FixVersion version of the package that fixes the vulnerability
+	// +optional
+	FixVersion string `json:"fixVersion,omitempty"`
+
+	// Description of the vulnerability
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// Links related to the vulnerability
+	// +optional
+	Links []string `json:"links,omitempty"`
+}
+
+// ScanReportOptions options for getting scan report
+type ScanReportOptions struct {
+	// WithVulnerabilities returns detailed vulnerabilities in the report
+	// +optional
+	WithVulnerabilities bool `json:"withVulnerabilities"`
+}
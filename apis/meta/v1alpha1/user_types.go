@@ -0,0 +1,69 @@
+/*
+Copyright 2021 The Katanomi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var UserGVK = GroupVersion.WithKind("User")
+var UserListGVK = GroupVersion.WithKind("UserList")
+
+const (
+	// UserSearchNameKey search key to filter users by name
+	UserSearchNameKey = "name"
+	// UserSearchEmailKey search key to filter users by email
+	UserSearchEmailKey = "email"
+)
+
+// User object for plugins
+type User struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec UserSpec `json:"spec"`
+}
+
+// UserSpec spec for user
+type UserSpec struct {
+	// Username login name of the user in the tool
+	Username string `json:"username"`
+
+	// DisplayName name displayed for the user
+	// +optional
+	DisplayName string `json:"displayName,omitempty"`
+
+	// Email of the user
+	// +optional
+	Email string `json:"email,omitempty"`
+
+	// AvatarURL url of the user avatar
+	// +optional
+	AvatarURL string `json:"avatarURL,omitempty"`
+
+	// Properties extended properties for User
+	Properties *runtime.RawExtension `json:"properties,omitempty"`
+}
+
+// UserList list of users
+type UserList struct {
+	metav1.TypeMeta `json:",inline"`
+	ListMeta        `json:"metadata,omitempty"`
+
+	Items []User `json:"items"`
+}
@@ -51,3 +51,17 @@ type WebhookRegisterStatus struct {
 	// +optional
 	Body []byte `json:"body"`
 }
+
+// WebhookResourceComparePayload payload to compare two webhook resources
+type WebhookResourceComparePayload struct {
+	// Source resource to be compared
+	Source ResourceURI `json:"source"`
+	// Target resource to be compared with
+	Target ResourceURI `json:"target"`
+}
+
+// WebhookResourceCompareResult result of comparing two webhook resources
+type WebhookResourceCompareResult struct {
+	// Same is true if both resources are the same
+	Same bool `json:"same"`
+}
@@ -0,0 +1,120 @@
+/*
+Copyright 2021 The Katanomi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	metav1alpha1 "github.com/katanomi/pkg/apis/meta/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+// ClientArtifact client for artifacts
+type ClientArtifact interface {
+	ListTags(ctx context.Context, baseURL *duckv1.Addressable, params metav1alpha1.ArtifactOptions, options ...OptionFunc) (*metav1alpha1.ArtifactTagList, error)
+	Copy(ctx context.Context, baseURL *duckv1.Addressable, params metav1alpha1.ArtifactOptions, option metav1alpha1.ArtifactCopyOptions, options ...OptionFunc) (*metav1alpha1.Artifact, error)
+	GetScanReport(ctx context.Context, baseURL *duckv1.Addressable, params metav1alpha1.ArtifactOptions, option metav1alpha1.ScanReportOptions, options ...OptionFunc) (*metav1alpha1.ScanReport, error)
+}
+
+type artifact struct {
+	client Client
+	meta   Meta
+	secret corev1.Secret
+}
+
+func newArtifact(client Client, meta Meta, secret corev1.Secret) ClientArtifact {
+	return &artifact{
+		client: client,
+		meta:   meta,
+		secret: secret,
+	}
+}
+
+// ListTags list tags of a repository, or of an artifact when Artifact is set, using plugin
+func (a *artifact) ListTags(ctx context.Context, baseURL *duckv1.Addressable, params metav1alpha1.ArtifactOptions, options ...OptionFunc) (*metav1alpha1.ArtifactTagList, error) {
+	var uri string
+	if params.Artifact == "" {
+		if params.Project == "" {
+			return nil, errors.New("project is empty string")
+		} else if params.Repository == "" {
+			return nil, errors.New("repository is empty string")
+		}
+		uri = fmt.Sprintf("projects/%s/repositories/%s/tags", params.Project, params.Repository)
+	} else {
+		artifactPath, err := artifactURI(params)
+		if err != nil {
+			return nil, err
+		}
+		uri = artifactPath + "/tags"
+	}
+
+	list := &metav1alpha1.ArtifactTagList{}
+	options = append(options, MetaOpts(a.meta), SecretOpts(a.secret), ResultOpts(list))
+	if err := a.client.Get(ctx, baseURL, uri, options...); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+// Copy copy or promote an artifact to another project or repository using plugin
+func (a *artifact) Copy(ctx context.Context, baseURL *duckv1.Addressable, params metav1alpha1.ArtifactOptions, option metav1alpha1.ArtifactCopyOptions, options ...OptionFunc) (*metav1alpha1.Artifact, error) {
+	uri, err := artifactURI(params)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &metav1alpha1.Artifact{}
+	options = append(options, MetaOpts(a.meta), SecretOpts(a.secret), BodyOpts(option), ResultOpts(resp))
+	if err := a.client.Post(ctx, baseURL, uri+"/copy", options...); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// GetScanReport get scan status and vulnerability report of an artifact using plugin
+func (a *artifact) GetScanReport(ctx context.Context, baseURL *duckv1.Addressable, params metav1alpha1.ArtifactOptions, option metav1alpha1.ScanReportOptions, options ...OptionFunc) (*metav1alpha1.ScanReport, error) {
+	uri, err := artifactURI(params)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &metav1alpha1.ScanReport{}
+	query := map[string]string{"withVulnerabilities": strconv.FormatBool(option.WithVulnerabilities)}
+	options = append(options, MetaOpts(a.meta), SecretOpts(a.secret), QueryOpts(query), ResultOpts(report))
+	if err := a.client.Get(ctx, baseURL, uri+"/scan", options...); err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
+
+func artifactURI(params metav1alpha1.ArtifactOptions) (string, error) {
+	if params.Project == "" {
+		return "", errors.New("project is empty string")
+	} else if params.Repository == "" {
+		return "", errors.New("repository is empty string")
+	} else if params.Artifact == "" {
+		return "", errors.New("artifact is empty string")
+	}
+	return fmt.Sprintf("projects/%s/repositories/%s/artifacts/%s", params.Project, params.Repository, params.Artifact), nil
+}
@@ -77,6 +77,14 @@ func FromSecret(secret corev1.Secret) *Auth {
 	}
 }
 
+// ToSecret generate a secret from auth, reverse of FromSecret
+func (a *Auth) ToSecret() corev1.Secret {
+	return corev1.Secret{
+		Type: corev1.SecretType(a.Type),
+		Data: a.Secret,
+	}
+}
+
 // IsBasic check auth is basic
 func (a *Auth) IsBasic() bool {
 	return a.Type == v1alpha1.AuthTypeBasic
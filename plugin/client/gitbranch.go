@@ -31,6 +31,9 @@ import (
 type ClientGitBranch interface {
 	List(ctx context.Context, baseURL *duckv1.Addressable, repo metav1alpha1.GitRepo, options ...OptionFunc) (*metav1alpha1.GitBranchList, error)
 	Create(ctx context.Context, baseURL *duckv1.Addressable, payload metav1alpha1.CreateBranchPayload, options ...OptionFunc) (*metav1alpha1.GitBranch, error)
+	Delete(ctx context.Context, baseURL *duckv1.Addressable, option metav1alpha1.GitBranchOption, options ...OptionFunc) error
+	Protect(ctx context.Context, baseURL *duckv1.Addressable, payload metav1alpha1.BranchProtectionPayload, options ...OptionFunc) (*metav1alpha1.GitBranch, error)
+	Unprotect(ctx context.Context, baseURL *duckv1.Addressable, option metav1alpha1.GitBranchOption, options ...OptionFunc) (*metav1alpha1.GitBranch, error)
 }
 
 type gitBranch struct {
@@ -75,3 +78,53 @@ func (g *gitBranch) Create(ctx context.Context, baseURL *duckv1.Addressable, pay
 
 	return branchObj, nil
 }
+
+// Delete delete branch
+func (g *gitBranch) Delete(ctx context.Context, baseURL *duckv1.Addressable, option metav1alpha1.GitBranchOption, options ...OptionFunc) error {
+	if err := validateBranchOption(option); err != nil {
+		return err
+	}
+	options = append(options, MetaOpts(g.meta), SecretOpts(g.secret), QueryOpts(map[string]string{"branch": option.Branch}))
+	uri := fmt.Sprintf("projects/%s/coderepositories/%s/branches", option.Project, option.Repository)
+	return g.client.Delete(ctx, baseURL, uri, options...)
+}
+
+// Protect set protection rules for branch
+func (g *gitBranch) Protect(ctx context.Context, baseURL *duckv1.Addressable, payload metav1alpha1.BranchProtectionPayload, options ...OptionFunc) (*metav1alpha1.GitBranch, error) {
+	branchObj := &metav1alpha1.GitBranch{}
+	if err := validateBranchOption(payload.GitBranchOption); err != nil {
+		return nil, err
+	}
+	options = append(options, MetaOpts(g.meta), SecretOpts(g.secret), QueryOpts(map[string]string{"branch": payload.Branch}),
+		BodyOpts(payload.BranchProtectionParams), ResultOpts(branchObj))
+	if err := g.client.Put(ctx, baseURL, branchProtectionURI(payload.GitBranchOption), options...); err != nil {
+		return nil, err
+	}
+	return branchObj, nil
+}
+
+// Unprotect remove protection rules from branch
+func (g *gitBranch) Unprotect(ctx context.Context, baseURL *duckv1.Addressable, option metav1alpha1.GitBranchOption, options ...OptionFunc) (*metav1alpha1.GitBranch, error) {
+	branchObj := &metav1alpha1.GitBranch{}
+	if err := validateBranchOption(option); err != nil {
+		return nil, err
+	}
+	options = append(options, MetaOpts(g.meta), SecretOpts(g.secret), QueryOpts(map[string]string{"branch": option.Branch}), ResultOpts(branchObj))
+	if err := g.client.Delete(ctx, baseURL, branchProtectionURI(option), options...); err != nil {
+		return nil, err
+	}
+	return branchObj, nil
+}
+
+func validateBranchOption(option metav1alpha1.GitBranchOption) error {
+	if option.Repository == "" {
+		return errors.New("repo is empty string")
+	} else if option.Branch == "" {
+		return errors.New("branch is empty string")
+	}
+	return nil
+}
+
+func branchProtectionURI(option metav1alpha1.GitBranchOption) string {
+	return fmt.Sprintf("projects/%s/coderepositories/%s/branches/protection", option.Project, option.Repository)
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2021 The Katanomi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/jarcoal/httpmock"
+	metav1alpha1 "github.com/katanomi/pkg/apis/meta/v1alpha1"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+func TestGitBranchWithSlash(t *testing.T) {
+	g := NewGomegaWithT(t)
+	httpmock.Reset()
+
+	branches := []string{}
+	responder := func(req *http.Request) (*http.Response, error) {
+		branches = append(branches, req.Method+" "+req.URL.Path+" "+req.URL.Query().Get("branch"))
+		return httpmock.NewJsonResponse(http.StatusOK, metav1alpha1.GitBranch{})
+	}
+	httpmock.RegisterResponder("DELETE", "https://example.com/api/v1/projects/katanomi/coderepositories/pkg/branches", responder)
+	httpmock.RegisterResponder("PUT", "https://example.com/api/v1/projects/katanomi/coderepositories/pkg/branches/protection", responder)
+	httpmock.RegisterResponder("DELETE", "https://example.com/api/v1/projects/katanomi/coderepositories/pkg/branches/protection", responder)
+
+	RESTClient := resty.New()
+	httpmock.ActivateNonDefault(RESTClient.GetClient())
+	client := NewPluginClient(ClientOpts(RESTClient))
+
+	url, _ := apis.ParseURL("https://example.com/api/v1")
+	baseURL := &duckv1.Addressable{URL: url}
+	option := metav1alpha1.GitBranchOption{
+		GitRepo: metav1alpha1.GitRepo{Project: "katanomi", Repository: "pkg"},
+		Branch:  "release/1.0",
+	}
+	branchClient := client.GitBranch(Meta{}, corev1.Secret{})
+
+	g.Expect(branchClient.Delete(context.Background(), baseURL, option)).To(Succeed())
+	_, err := branchClient.Protect(context.Background(), baseURL, metav1alpha1.BranchProtectionPayload{GitBranchOption: option})
+	g.Expect(err).To(BeNil())
+	_, err = branchClient.Unprotect(context.Background(), baseURL, option)
+	g.Expect(err).To(BeNil())
+
+	g.Expect(branches).To(Equal([]string{
+		"DELETE /api/v1/projects/katanomi/coderepositories/pkg/branches release/1.0",
+		"PUT /api/v1/projects/katanomi/coderepositories/pkg/branches/protection release/1.0",
+		"DELETE /api/v1/projects/katanomi/coderepositories/pkg/branches/protection release/1.0",
+	}))
+}
+
+func TestGitBranchValidation(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	client := NewPluginClient()
+	option := metav1alpha1.GitBranchOption{
+		GitRepo: metav1alpha1.GitRepo{Project: "katanomi", Repository: "pkg"},
+	}
+	err := client.GitBranch(Meta{}, corev1.Secret{}).Delete(context.Background(), &duckv1.Addressable{}, option)
+
+	g.Expect(err).NotTo(BeNil())
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2021 The Katanomi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"errors"
+
+	corev1 "k8s.io/api/core/v1"
+
+	metav1alpha1 "github.com/katanomi/pkg/apis/meta/v1alpha1"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+// ClientGitCodeSearch client for code search
+type ClientGitCodeSearch interface {
+	Search(ctx context.Context, baseURL *duckv1.Addressable, option metav1alpha1.GitCodeSearchOption, options ...OptionFunc) (*metav1alpha1.GitCodeSearchResultList, error)
+}
+
+type gitCodeSearch struct {
+	client Client
+	meta   Meta
+	secret corev1.Secret
+}
+
+func newGitCodeSearch(client Client, meta Meta, secret corev1.Secret) ClientGitCodeSearch {
+	return &gitCodeSearch{
+		client: client,
+		meta:   meta,
+		secret: secret,
+	}
+}
+
+// Search search code, pagination is set by ListOpts
+func (g *gitCodeSearch) Search(ctx context.Context, baseURL *duckv1.Addressable, option metav1alpha1.GitCodeSearchOption, options ...OptionFunc) (*metav1alpha1.GitCodeSearchResultList, error) {
+	if option.Query == "" {
+		return nil, errors.New("query is empty string")
+	}
+
+	query := map[string]string{"query": option.Query}
+	for key, value := range map[string]string{
+		"project":    option.Project,
+		"repository": option.Repository,
+		"path":       option.Path,
+		"language":   option.Language,
+	} {
+		if value != "" {
+			query[key] = value
+		}
+	}
+
+	list := &metav1alpha1.GitCodeSearchResultList{}
+	options = append(options, MetaOpts(g.meta), SecretOpts(g.secret), QueryOpts(query), ResultOpts(list))
+	if err := g.client.Get(ctx, baseURL, "code/search", options...); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
@@ -20,6 +20,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 
@@ -30,6 +31,8 @@ import (
 // ClientGitCommit client for commit
 type ClientGitCommit interface {
 	Get(ctx context.Context, baseURL *duckv1.Addressable, option metav1alpha1.GitCommitOption, options ...OptionFunc) (*metav1alpha1.GitCommit, error)
+	List(ctx context.Context, baseURL *duckv1.Addressable, option metav1alpha1.GitCommitListOption, options ...OptionFunc) (*metav1alpha1.GitCommitList, error)
+	Compare(ctx context.Context, baseURL *duckv1.Addressable, option metav1alpha1.GitCompareOption, options ...OptionFunc) (*metav1alpha1.GitCompare, error)
 }
 
 type gitCommit struct {
@@ -50,12 +53,8 @@ func newGitCommit(client Client, meta Meta, secret corev1.Secret) ClientGitCommi
 func (g *gitCommit) Get(ctx context.Context, baseURL *duckv1.Addressable, option metav1alpha1.GitCommitOption, options ...OptionFunc) (*metav1alpha1.GitCommit, error) {
 	commitObj := &metav1alpha1.GitCommit{}
 	options = append(options, MetaOpts(g.meta), SecretOpts(g.secret), ResultOpts(commitObj))
-	if option.Repository == "" {
-		return nil, errors.New("repo is empty string")
-	} else if option.SHA == nil {
-		return nil, errors.New("sha is null")
-	} else if *option.SHA == "" {
-		return nil, errors.New("sha is empty string")
+	if err := validateCommitOption(option); err != nil {
+		return nil, err
 	}
 	uri := fmt.Sprintf("projects/%s/coderepositories/%s/commit/%s", option.Project, option.Repository, *option.SHA)
 	if err := g.client.Get(ctx, baseURL, uri, options...); err != nil {
@@ -63,3 +62,61 @@ func (g *gitCommit) Get(ctx context.Context, baseURL *duckv1.Addressable, option
 	}
 	return commitObj, nil
 }
+
+// List commits with filters
+func (g *gitCommit) List(ctx context.Context, baseURL *duckv1.Addressable, option metav1alpha1.GitCommitListOption, options ...OptionFunc) (*metav1alpha1.GitCommitList, error) {
+	list := &metav1alpha1.GitCommitList{}
+	query := map[string]string{}
+	if option.Ref != "" {
+		query["ref"] = option.Ref
+	}
+	if option.Path != "" {
+		query["path"] = option.Path
+	}
+	if option.Author != "" {
+		query["author"] = option.Author
+	}
+	if option.Since != nil {
+		query["since"] = option.Since.Format(time.RFC3339)
+	}
+	if option.Until != nil {
+		query["until"] = option.Until.Format(time.RFC3339)
+	}
+	options = append(options, MetaOpts(g.meta), SecretOpts(g.secret), QueryOpts(query), ResultOpts(list))
+	if option.Repository == "" {
+		return nil, errors.New("repo is empty string")
+	}
+	uri := fmt.Sprintf("projects/%s/coderepositories/%s/commits", option.Project, option.Repository)
+	if err := g.client.Get(ctx, baseURL, uri, options...); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// Compare compare two refs
+func (g *gitCommit) Compare(ctx context.Context, baseURL *duckv1.Addressable, option metav1alpha1.GitCompareOption, options ...OptionFunc) (*metav1alpha1.GitCompare, error) {
+	compareObj := &metav1alpha1.GitCompare{}
+	options = append(options, MetaOpts(g.meta), SecretOpts(g.secret), QueryOpts(map[string]string{"base": option.Base, "head": option.Head}), ResultOpts(compareObj))
+	if option.Repository == "" {
+		return nil, errors.New("repo is empty string")
+	} else if option.Base == "" || option.Head == "" {
+		return nil, errors.New("base and head are required")
+	}
+	uri := fmt.Sprintf("projects/%s/coderepositories/%s/compare", option.Project, option.Repository)
+	if err := g.client.Get(ctx, baseURL, uri, options...); err != nil {
+		return nil, err
+	}
+	return compareObj, nil
+}
+
+// validateCommitOption check repository and sha of the commit option
+func validateCommitOption(option metav1alpha1.GitCommitOption) error {
+	if option.Repository == "" {
+		return errors.New("repo is empty string")
+	} else if option.SHA == nil {
+		return errors.New("sha is null")
+	} else if *option.SHA == "" {
+		return errors.New("sha is empty string")
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2021 The Katanomi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+
+	metav1alpha1 "github.com/katanomi/pkg/apis/meta/v1alpha1"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+// ClientGitCommitStatus client for commit status
+type ClientGitCommitStatus interface {
+	List(ctx context.Context, baseURL *duckv1.Addressable, option metav1alpha1.GitCommitOption, options ...OptionFunc) (*metav1alpha1.GitCommitStatusList, error)
+	Create(ctx context.Context, baseURL *duckv1.Addressable, payload metav1alpha1.CreateCommitStatusPayload, options ...OptionFunc) (*metav1alpha1.GitCommitStatus, error)
+}
+
+type gitCommitStatus struct {
+	client Client
+	meta   Meta
+	secret corev1.Secret
+}
+
+func newGitCommitStatus(client Client, meta Meta, secret corev1.Secret) ClientGitCommitStatus {
+	return &gitCommitStatus{
+		client: client,
+		meta:   meta,
+		secret: secret,
+	}
+}
+
+// List list statuses of a commit
+func (g *gitCommitStatus) List(ctx context.Context, baseURL *duckv1.Addressable, option metav1alpha1.GitCommitOption, options ...OptionFunc) (*metav1alpha1.GitCommitStatusList, error) {
+	list := &metav1alpha1.GitCommitStatusList{}
+	options = append(options, MetaOpts(g.meta), SecretOpts(g.secret), ResultOpts(list))
+	if err := validateCommitOption(option); err != nil {
+		return nil, err
+	}
+	uri := fmt.Sprintf("projects/%s/coderepositories/%s/commit/%s/statuses", option.Project, option.Repository, *option.SHA)
+	if err := g.client.Get(ctx, baseURL, uri, options...); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// Create report a status for a commit
+func (g *gitCommitStatus) Create(ctx context.Context, baseURL *duckv1.Addressable, payload metav1alpha1.CreateCommitStatusPayload, options ...OptionFunc) (*metav1alpha1.GitCommitStatus, error) {
+	statusObj := &metav1alpha1.GitCommitStatus{}
+	options = append(options, MetaOpts(g.meta), SecretOpts(g.secret), BodyOpts(payload.CreateCommitStatusParams), ResultOpts(statusObj))
+	if err := validateCommitOption(payload.GitCommitOption); err != nil {
+		return nil, err
+	}
+	if payload.State == "" {
+		return nil, errors.New("state is empty string")
+	}
+	if !payload.State.IsValid() {
+		return nil, fmt.Errorf("invalid state %q", payload.State)
+	}
+	uri := fmt.Sprintf("projects/%s/coderepositories/%s/commit/%s/statuses", payload.Project, payload.Repository, *payload.SHA)
+	if err := g.client.Post(ctx, baseURL, uri, options...); err != nil {
+		return nil, err
+	}
+	return statusObj, nil
+}
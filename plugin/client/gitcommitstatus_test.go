@@ -0,0 +1,94 @@
+/*
+Copyright 2021 The Katanomi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/jarcoal/httpmock"
+	metav1alpha1 "github.com/katanomi/pkg/apis/meta/v1alpha1"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+func TestGitCommitStatusCreate(t *testing.T) {
+	g := NewGomegaWithT(t)
+	httpmock.Reset()
+
+	var params metav1alpha1.CreateCommitStatusParams
+	fakeUrl := "https://example.com/api/v1/projects/katanomi/coderepositories/pkg/commit/abc/statuses"
+	httpmock.RegisterResponder("POST", fakeUrl, func(req *http.Request) (*http.Response, error) {
+		if err := json.NewDecoder(req.Body).Decode(&params); err != nil {
+			return nil, err
+		}
+		return httpmock.NewJsonResponse(http.StatusOK, metav1alpha1.GitCommitStatus{
+			Spec: metav1alpha1.GitCommitStatusSpec{ID: 1, State: params.State, Context: params.Context},
+		})
+	})
+
+	RESTClient := resty.New()
+	httpmock.ActivateNonDefault(RESTClient.GetClient())
+	client := NewPluginClient(ClientOpts(RESTClient))
+
+	url, _ := apis.ParseURL("https://example.com/api/v1")
+	sha := "abc"
+	coverage := 87.5
+	payload := metav1alpha1.CreateCommitStatusPayload{
+		GitCommitOption: metav1alpha1.GitCommitOption{
+			GitRepo:            metav1alpha1.GitRepo{Project: "katanomi", Repository: "pkg"},
+			GitCommitBasicInfo: metav1alpha1.GitCommitBasicInfo{SHA: &sha},
+		},
+		CreateCommitStatusParams: metav1alpha1.CreateCommitStatusParams{
+			State:     metav1alpha1.GitCommitStatusStateFailure,
+			Context:   "ci/test",
+			TargetURL: "https://ci.example.com/1",
+			Coverage:  &coverage,
+		},
+	}
+	status, err := client.GitCommitStatus(Meta{}, corev1.Secret{}).Create(context.Background(), &duckv1.Addressable{URL: url}, payload)
+
+	g.Expect(err).To(BeNil())
+	g.Expect(status.Spec.ID).To(Equal(int64(1)))
+	g.Expect(params).To(Equal(payload.CreateCommitStatusParams))
+}
+
+func TestGitCommitStatusCreateValidation(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	client := NewPluginClient()
+	sha := "abc"
+	payload := metav1alpha1.CreateCommitStatusPayload{
+		GitCommitOption: metav1alpha1.GitCommitOption{
+			GitRepo:            metav1alpha1.GitRepo{Project: "katanomi", Repository: "pkg"},
+			GitCommitBasicInfo: metav1alpha1.GitCommitBasicInfo{SHA: &sha},
+		},
+	}
+	_, err := client.GitCommitStatus(Meta{}, corev1.Secret{}).Create(context.Background(), &duckv1.Addressable{}, payload)
+
+	g.Expect(err).NotTo(BeNil())
+
+	payload.State = "running"
+	_, err = client.GitCommitStatus(Meta{}, corev1.Secret{}).Create(context.Background(), &duckv1.Addressable{}, payload)
+
+	g.Expect(err).NotTo(BeNil())
+}
@@ -20,6 +20,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
 
 	corev1 "k8s.io/api/core/v1"
 
@@ -30,6 +31,9 @@ import (
 type ClientGitContent interface {
 	Get(ctx context.Context, baseURL *duckv1.Addressable, option metav1alpha1.GitRepoFileOption, options ...OptionFunc) (*metav1alpha1.GitRepoFile, error)
 	Create(ctx context.Context, baseURL *duckv1.Addressable, payload metav1alpha1.CreateRepoFilePayload, options ...OptionFunc) (*metav1alpha1.GitCommit, error)
+	Update(ctx context.Context, baseURL *duckv1.Addressable, payload metav1alpha1.UpdateRepoFilePayload, options ...OptionFunc) (*metav1alpha1.GitCommit, error)
+	Delete(ctx context.Context, baseURL *duckv1.Addressable, payload metav1alpha1.DeleteRepoFilePayload, options ...OptionFunc) (*metav1alpha1.GitCommit, error)
+	ListTree(ctx context.Context, baseURL *duckv1.Addressable, option metav1alpha1.GitRepoTreeOption, options ...OptionFunc) (*metav1alpha1.GitRepoTreeEntryList, error)
 }
 
 type gitContent struct {
@@ -66,11 +70,70 @@ func (g *gitContent) Create(ctx context.Context, baseURL *duckv1.Addressable, pa
 	options = append(options, MetaOpts(g.meta), SecretOpts(g.secret), BodyOpts(payload.CreateRepoFileParams), ResultOpts(commitInfo))
 	if payload.Repository == "" {
 		return nil, errors.New("repo is empty string")
+	} else if payload.FilePath == "" {
+		return nil, errors.New("file path is empty string")
 	}
-	uri := fmt.Sprintf("projects/%s/coderepositories/%s/content", payload.Project, payload.Repository)
+	uri := fmt.Sprintf("projects/%s/coderepositories/%s/content/%s", payload.Project, payload.Repository, payload.FilePath)
 	if err := g.client.Post(ctx, baseURL, uri, options...); err != nil {
 		return nil, err
 	}
 
 	return commitInfo, nil
 }
+
+// Update update an existing file and commit + push
+func (g *gitContent) Update(ctx context.Context, baseURL *duckv1.Addressable, payload metav1alpha1.UpdateRepoFilePayload, options ...OptionFunc) (*metav1alpha1.GitCommit, error) {
+	commitInfo := &metav1alpha1.GitCommit{}
+	options = append(options, MetaOpts(g.meta), SecretOpts(g.secret), BodyOpts(payload.UpdateRepoFileParams), ResultOpts(commitInfo))
+	if payload.Repository == "" {
+		return nil, errors.New("repo is empty string")
+	} else if payload.FilePath == "" {
+		return nil, errors.New("file path is empty string")
+	} else if payload.SHA == "" {
+		return nil, errors.New("file sha is empty string")
+	}
+	uri := fmt.Sprintf("projects/%s/coderepositories/%s/content/%s", payload.Project, payload.Repository, payload.FilePath)
+	if err := g.client.Put(ctx, baseURL, uri, options...); err != nil {
+		return nil, err
+	}
+
+	return commitInfo, nil
+}
+
+// Delete delete an existing file and commit + push
+func (g *gitContent) Delete(ctx context.Context, baseURL *duckv1.Addressable, payload metav1alpha1.DeleteRepoFilePayload, options ...OptionFunc) (*metav1alpha1.GitCommit, error) {
+	commitInfo := &metav1alpha1.GitCommit{}
+	options = append(options, MetaOpts(g.meta), SecretOpts(g.secret), BodyOpts(payload.DeleteRepoFileParams), ResultOpts(commitInfo))
+	if payload.Repository == "" {
+		return nil, errors.New("repo is empty string")
+	} else if payload.FilePath == "" {
+		return nil, errors.New("file path is empty string")
+	} else if payload.SHA == "" {
+		return nil, errors.New("file sha is empty string")
+	}
+	uri := fmt.Sprintf("projects/%s/coderepositories/%s/content/%s", payload.Project, payload.Repository, payload.FilePath)
+	if err := g.client.Delete(ctx, baseURL, uri, options...); err != nil {
+		return nil, err
+	}
+
+	return commitInfo, nil
+}
+
+// ListTree list entries of a repository directory
+func (g *gitContent) ListTree(ctx context.Context, baseURL *duckv1.Addressable, option metav1alpha1.GitRepoTreeOption, options ...OptionFunc) (*metav1alpha1.GitRepoTreeEntryList, error) {
+	list := &metav1alpha1.GitRepoTreeEntryList{}
+	query := map[string]string{
+		"ref":       option.Ref,
+		"path":      option.Path,
+		"recursive": strconv.FormatBool(option.Recursive),
+	}
+	options = append(options, MetaOpts(g.meta), SecretOpts(g.secret), QueryOpts(query), ResultOpts(list))
+	if option.Repository == "" {
+		return nil, errors.New("repo is empty string")
+	}
+	uri := fmt.Sprintf("projects/%s/coderepositories/%s/tree", option.Project, option.Repository)
+	if err := g.client.Get(ctx, baseURL, uri, options...); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
@@ -0,0 +1,89 @@
+/*
+Copyright 2021 The Katanomi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/jarcoal/httpmock"
+	metav1alpha1 "github.com/katanomi/pkg/apis/meta/v1alpha1"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+func TestGitContentNestedPath(t *testing.T) {
+	g := NewGomegaWithT(t)
+	httpmock.Reset()
+
+	fakeUrl := "https://example.com/api/v1/projects/katanomi/coderepositories/pkg/content/config/app.yaml"
+	methods := []string{}
+	responder := func(req *http.Request) (*http.Response, error) {
+		methods = append(methods, req.Method)
+		return httpmock.NewJsonResponse(http.StatusOK, metav1alpha1.GitCommit{})
+	}
+	httpmock.RegisterResponder("POST", fakeUrl, responder)
+	httpmock.RegisterResponder("PUT", fakeUrl, responder)
+	httpmock.RegisterResponder("DELETE", fakeUrl, responder)
+
+	RESTClient := resty.New()
+	httpmock.ActivateNonDefault(RESTClient.GetClient())
+	client := NewPluginClient(ClientOpts(RESTClient))
+
+	url, _ := apis.ParseURL("https://example.com/api/v1")
+	baseURL := &duckv1.Addressable{URL: url}
+	repo := metav1alpha1.GitRepo{Project: "katanomi", Repository: "pkg"}
+	contentClient := client.GitContent(Meta{}, corev1.Secret{})
+
+	_, err := contentClient.Create(context.Background(), baseURL, metav1alpha1.CreateRepoFilePayload{
+		GitRepo:              repo,
+		CreateRepoFileParams: metav1alpha1.CreateRepoFileParams{Branch: "main"},
+		FilePath:             "config/app.yaml",
+	})
+	g.Expect(err).To(BeNil())
+	_, err = contentClient.Update(context.Background(), baseURL, metav1alpha1.UpdateRepoFilePayload{
+		GitRepo:              repo,
+		UpdateRepoFileParams: metav1alpha1.UpdateRepoFileParams{Branch: "main", SHA: "abc"},
+		FilePath:             "config/app.yaml",
+	})
+	g.Expect(err).To(BeNil())
+	_, err = contentClient.Delete(context.Background(), baseURL, metav1alpha1.DeleteRepoFilePayload{
+		GitRepo:              repo,
+		DeleteRepoFileParams: metav1alpha1.DeleteRepoFileParams{Branch: "main", SHA: "abc"},
+		FilePath:             "config/app.yaml",
+	})
+	g.Expect(err).To(BeNil())
+
+	g.Expect(methods).To(Equal([]string{"POST", "PUT", "DELETE"}))
+}
+
+func TestGitContentUpdateValidation(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	client := NewPluginClient()
+	payload := metav1alpha1.UpdateRepoFilePayload{
+		GitRepo:  metav1alpha1.GitRepo{Project: "katanomi", Repository: "pkg"},
+		FilePath: "config/app.yaml",
+	}
+	_, err := client.GitContent(Meta{}, corev1.Secret{}).Update(context.Background(), &duckv1.Addressable{}, payload)
+
+	g.Expect(err).NotTo(BeNil())
+}
@@ -33,8 +33,19 @@ import (
 type ClientGitPullRequest interface {
 	Create(ctx context.Context, baseURL *duckv1.Addressable, payload metav1alpha1.CreatePullRequestPayload, options ...OptionFunc) (*metav1alpha1.GitPullRequest, error)
 	CreateNote(ctx context.Context, baseURL *duckv1.Addressable, payload metav1alpha1.CreatePullRequestCommentPayload, options ...OptionFunc) (*metav1alpha1.GitPullRequestNote, error)
+	ListNote(ctx context.Context, baseURL *duckv1.Addressable, option metav1alpha1.GitPullRequestOption, options ...OptionFunc) (*metav1alpha1.GitPullRequestNoteList, error)
+	UpdateNote(ctx context.Context, baseURL *duckv1.Addressable, payload metav1alpha1.UpdatePullRequestCommentPayload, options ...OptionFunc) (*metav1alpha1.GitPullRequestNote, error)
+	DeleteNote(ctx context.Context, baseURL *duckv1.Addressable, option metav1alpha1.GitPullRequestCommentOption, options ...OptionFunc) error
+	ListReview(ctx context.Context, baseURL *duckv1.Addressable, option metav1alpha1.GitPullRequestOption, options ...OptionFunc) (*metav1alpha1.GitPullRequestReviewList, error)
+	CreateReview(ctx context.Context, baseURL *duckv1.Addressable, payload metav1alpha1.CreatePullRequestReviewPayload, options ...OptionFunc) (*metav1alpha1.GitPullRequestReview, error)
 	List(ctx context.Context, baseURL *duckv1.Addressable, option metav1alpha1.GitRepo, options ...OptionFunc) (*metav1alpha1.GitPullRequestList, error)
 	Get(ctx context.Context, baseURL *duckv1.Addressable, option metav1alpha1.GitPullRequestOption, options ...OptionFunc) (*metav1alpha1.GitPullRequest, error)
+	Merge(ctx context.Context, baseURL *duckv1.Addressable, payload metav1alpha1.MergePullRequestPayload, options ...OptionFunc) (*metav1alpha1.GitPullRequest, error)
+	Close(ctx context.Context, baseURL *duckv1.Addressable, option metav1alpha1.GitPullRequestOption, options ...OptionFunc) (*metav1alpha1.GitPullRequest, error)
+	Reopen(ctx context.Context, baseURL *duckv1.Addressable, option metav1alpha1.GitPullRequestOption, options ...OptionFunc) (*metav1alpha1.GitPullRequest, error)
+	Update(ctx context.Context, baseURL *duckv1.Addressable, payload metav1alpha1.UpdatePullRequestPayload, options ...OptionFunc) (*metav1alpha1.GitPullRequest, error)
+	ListCommits(ctx context.Context, baseURL *duckv1.Addressable, option metav1alpha1.GitPullRequestOption, options ...OptionFunc) (*metav1alpha1.GitCommitList, error)
+	ListFiles(ctx context.Context, baseURL *duckv1.Addressable, option metav1alpha1.GitPullRequestOption, options ...OptionFunc) (*metav1alpha1.GitChangedFileList, error)
 }
 
 type gitPullRequest struct {
@@ -111,9 +122,199 @@ func (g *gitPullRequest) CreateNote(ctx context.Context, baseURL *duckv1.Address
 		return nil, errors.New("pr's index is unknown")
 	}
 	index := strconv.Itoa(payload.Index)
-	uri := fmt.Sprintf("projects/%s/coderepositories/%s/pulls/%s", payload.Project, payload.Repository, index)
+	uri := fmt.Sprintf("projects/%s/coderepositories/%s/pulls/%s/note", payload.Project, payload.Repository, index)
 	if err := g.client.Post(ctx, baseURL, uri, options...); err != nil {
 		return nil, err
 	}
 	return noteObj, nil
 }
+
+// ListNote list pr notes
+func (g *gitPullRequest) ListNote(ctx context.Context, baseURL *duckv1.Addressable, option metav1alpha1.GitPullRequestOption, options ...OptionFunc) (*metav1alpha1.GitPullRequestNoteList, error) {
+	noteList := &metav1alpha1.GitPullRequestNoteList{}
+	options = append(options, MetaOpts(g.meta), SecretOpts(g.secret), ResultOpts(noteList))
+	if option.Repository == "" {
+		return nil, errors.New("repo is empty string")
+	}
+	if option.Index < 1 {
+		return nil, errors.New("pr's index is unknown")
+	}
+	index := strconv.Itoa(option.Index)
+	uri := fmt.Sprintf("projects/%s/coderepositories/%s/pulls/%s/note", option.Project, option.Repository, index)
+	if err := g.client.Get(ctx, baseURL, uri, options...); err != nil {
+		return nil, err
+	}
+	return noteList, nil
+}
+
+// UpdateNote update pr note
+func (g *gitPullRequest) UpdateNote(ctx context.Context, baseURL *duckv1.Addressable, payload metav1alpha1.UpdatePullRequestCommentPayload, options ...OptionFunc) (*metav1alpha1.GitPullRequestNote, error) {
+	noteObj := &metav1alpha1.GitPullRequestNote{}
+	options = append(options, MetaOpts(g.meta), SecretOpts(g.secret), BodyOpts(payload.UpdatePullRequestCommentParam), ResultOpts(noteObj))
+	uri, err := pullRequestNoteURI(payload.GitPullRequestCommentOption)
+	if err != nil {
+		return nil, err
+	}
+	if err := g.client.Put(ctx, baseURL, uri, options...); err != nil {
+		return nil, err
+	}
+	return noteObj, nil
+}
+
+// DeleteNote delete pr note
+func (g *gitPullRequest) DeleteNote(ctx context.Context, baseURL *duckv1.Addressable, option metav1alpha1.GitPullRequestCommentOption, options ...OptionFunc) error {
+	options = append(options, MetaOpts(g.meta), SecretOpts(g.secret))
+	uri, err := pullRequestNoteURI(option)
+	if err != nil {
+		return err
+	}
+	return g.client.Delete(ctx, baseURL, uri, options...)
+}
+
+func pullRequestNoteURI(option metav1alpha1.GitPullRequestCommentOption) (string, error) {
+	if option.Repository == "" {
+		return "", errors.New("repo is empty string")
+	}
+	if option.Index < 1 {
+		return "", errors.New("pr's index is unknown")
+	}
+	if option.CommentID < 1 {
+		return "", errors.New("note id is unknown")
+	}
+	return fmt.Sprintf("projects/%s/coderepositories/%s/pulls/%d/note/%d", option.Project, option.Repository, option.Index, option.CommentID), nil
+}
+
+// Merge merge pr
+func (g *gitPullRequest) Merge(ctx context.Context, baseURL *duckv1.Addressable, payload metav1alpha1.MergePullRequestPayload, options ...OptionFunc) (*metav1alpha1.GitPullRequest, error) {
+	prObj := &metav1alpha1.GitPullRequest{}
+	options = append(options, MetaOpts(g.meta), SecretOpts(g.secret), BodyOpts(payload.MergePullRequestParams), ResultOpts(prObj))
+	if payload.Repository == "" {
+		return nil, errors.New("repo is empty string")
+	}
+	if payload.Index < 1 {
+		return nil, errors.New("pr's index is unknown")
+	}
+	index := strconv.Itoa(payload.Index)
+	uri := fmt.Sprintf("projects/%s/coderepositories/%s/pulls/%s/merge", payload.Project, payload.Repository, index)
+	if err := g.client.Put(ctx, baseURL, uri, options...); err != nil {
+		return nil, err
+	}
+	return prObj, nil
+}
+
+// Close close pr
+func (g *gitPullRequest) Close(ctx context.Context, baseURL *duckv1.Addressable, option metav1alpha1.GitPullRequestOption, options ...OptionFunc) (*metav1alpha1.GitPullRequest, error) {
+	return g.changeState(ctx, baseURL, option, "close", options...)
+}
+
+// Reopen reopen a closed pr
+func (g *gitPullRequest) Reopen(ctx context.Context, baseURL *duckv1.Addressable, option metav1alpha1.GitPullRequestOption, options ...OptionFunc) (*metav1alpha1.GitPullRequest, error) {
+	return g.changeState(ctx, baseURL, option, "reopen", options...)
+}
+
+func (g *gitPullRequest) changeState(ctx context.Context, baseURL *duckv1.Addressable, option metav1alpha1.GitPullRequestOption, action string, options ...OptionFunc) (*metav1alpha1.GitPullRequest, error) {
+	prObj := &metav1alpha1.GitPullRequest{}
+	options = append(options, MetaOpts(g.meta), SecretOpts(g.secret), ResultOpts(prObj))
+	if option.Repository == "" {
+		return nil, errors.New("repo is empty string")
+	}
+	if option.Index < 1 {
+		return nil, errors.New("pr's index is unknown")
+	}
+	index := strconv.Itoa(option.Index)
+	uri := fmt.Sprintf("projects/%s/coderepositories/%s/pulls/%s/%s", option.Project, option.Repository, index, action)
+	if err := g.client.Put(ctx, baseURL, uri, options...); err != nil {
+		return nil, err
+	}
+	return prObj, nil
+}
+
+// Update update pr title, description or target branch
+func (g *gitPullRequest) Update(ctx context.Context, baseURL *duckv1.Addressable, payload metav1alpha1.UpdatePullRequestPayload, options ...OptionFunc) (*metav1alpha1.GitPullRequest, error) {
+	prObj := &metav1alpha1.GitPullRequest{}
+	options = append(options, MetaOpts(g.meta), SecretOpts(g.secret), BodyOpts(payload.UpdatePullRequestParams), ResultOpts(prObj))
+	if payload.Repository == "" {
+		return nil, errors.New("repo is empty string")
+	}
+	if payload.Index < 1 {
+		return nil, errors.New("pr's index is unknown")
+	}
+	index := strconv.Itoa(payload.Index)
+	uri := fmt.Sprintf("projects/%s/coderepositories/%s/pulls/%s", payload.Project, payload.Repository, index)
+	if err := g.client.Put(ctx, baseURL, uri, options...); err != nil {
+		return nil, err
+	}
+	return prObj, nil
+}
+
+// ListCommits list commits of a pr
+func (g *gitPullRequest) ListCommits(ctx context.Context, baseURL *duckv1.Addressable, option metav1alpha1.GitPullRequestOption, options ...OptionFunc) (*metav1alpha1.GitCommitList, error) {
+	commitList := &metav1alpha1.GitCommitList{}
+	options = append(options, MetaOpts(g.meta), SecretOpts(g.secret), ResultOpts(commitList))
+	if option.Repository == "" {
+		return nil, errors.New("repo is empty string")
+	}
+	if option.Index < 1 {
+		return nil, errors.New("pr's index is unknown")
+	}
+	index := strconv.Itoa(option.Index)
+	uri := fmt.Sprintf("projects/%s/coderepositories/%s/pulls/%s/commits", option.Project, option.Repository, index)
+	if err := g.client.Get(ctx, baseURL, uri, options...); err != nil {
+		return nil, err
+	}
+	return commitList, nil
+}
+
+// ListFiles list changed files of a pr
+func (g *gitPullRequest) ListFiles(ctx context.Context, baseURL *duckv1.Addressable, option metav1alpha1.GitPullRequestOption, options ...OptionFunc) (*metav1alpha1.GitChangedFileList, error) {
+	fileList := &metav1alpha1.GitChangedFileList{}
+	options = append(options, MetaOpts(g.meta), SecretOpts(g.secret), ResultOpts(fileList))
+	if option.Repository == "" {
+		return nil, errors.New("repo is empty string")
+	}
+	if option.Index < 1 {
+		return nil, errors.New("pr's index is unknown")
+	}
+	index := strconv.Itoa(option.Index)
+	uri := fmt.Sprintf("projects/%s/coderepositories/%s/pulls/%s/files", option.Project, option.Repository, index)
+	if err := g.client.Get(ctx, baseURL, uri, options...); err != nil {
+		return nil, err
+	}
+	return fileList, nil
+}
+
+// ListReview list pr reviews
+func (g *gitPullRequest) ListReview(ctx context.Context, baseURL *duckv1.Addressable, option metav1alpha1.GitPullRequestOption, options ...OptionFunc) (*metav1alpha1.GitPullRequestReviewList, error) {
+	reviewList := &metav1alpha1.GitPullRequestReviewList{}
+	options = append(options, MetaOpts(g.meta), SecretOpts(g.secret), ResultOpts(reviewList))
+	if option.Repository == "" {
+		return nil, errors.New("repo is empty string")
+	}
+	if option.Index < 1 {
+		return nil, errors.New("pr's index is unknown")
+	}
+	index := strconv.Itoa(option.Index)
+	uri := fmt.Sprintf("projects/%s/coderepositories/%s/pulls/%s/reviews", option.Project, option.Repository, index)
+	if err := g.client.Get(ctx, baseURL, uri, options...); err != nil {
+		return nil, err
+	}
+	return reviewList, nil
+}
+
+// CreateReview submit a pr review
+func (g *gitPullRequest) CreateReview(ctx context.Context, baseURL *duckv1.Addressable, payload metav1alpha1.CreatePullRequestReviewPayload, options ...OptionFunc) (*metav1alpha1.GitPullRequestReview, error) {
+	reviewObj := &metav1alpha1.GitPullRequestReview{}
+	options = append(options, MetaOpts(g.meta), SecretOpts(g.secret), BodyOpts(payload.CreatePullRequestReviewParams), ResultOpts(reviewObj))
+	if payload.Repository == "" {
+		return nil, errors.New("repo is empty string")
+	}
+	if payload.Index < 1 {
+		return nil, errors.New("pr's index is unknown")
+	}
+	index := strconv.Itoa(payload.Index)
+	uri := fmt.Sprintf("projects/%s/coderepositories/%s/pulls/%s/reviews", payload.Project, payload.Repository, index)
+	if err := g.client.Post(ctx, baseURL, uri, options...); err != nil {
+		return nil, err
+	}
+	return reviewObj, nil
+}
@@ -0,0 +1,120 @@
+/*
+Copyright 2021 The Katanomi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/jarcoal/httpmock"
+	metav1alpha1 "github.com/katanomi/pkg/apis/meta/v1alpha1"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+func TestGitPullRequestNote(t *testing.T) {
+	g := NewGomegaWithT(t)
+	httpmock.Reset()
+
+	notesUrl := "https://example.com/api/v1/projects/katanomi/coderepositories/pkg/pulls/1/note"
+	httpmock.RegisterResponder("POST", notesUrl, func(req *http.Request) (*http.Response, error) {
+		params := metav1alpha1.CreatePullRequestCommentParam{}
+		if err := json.NewDecoder(req.Body).Decode(&params); err != nil {
+			return nil, err
+		}
+		return httpmock.NewJsonResponse(http.StatusOK, metav1alpha1.GitPullRequestNote{
+			Spec: metav1alpha1.GitPullRequestNoteSpec{ID: 1, Body: params.Body, Position: params.Position},
+		})
+	})
+	httpmock.RegisterResponder("GET", notesUrl, func(req *http.Request) (*http.Response, error) {
+		page, _ := strconv.Atoi(req.URL.Query().Get("page"))
+		list := metav1alpha1.GitPullRequestNoteList{
+			Items: []metav1alpha1.GitPullRequestNote{{Spec: metav1alpha1.GitPullRequestNoteSpec{ID: page}}},
+		}
+		list.TotalItems = 3
+		return httpmock.NewJsonResponse(http.StatusOK, list)
+	})
+	httpmock.RegisterResponder("PUT", notesUrl+"/2", func(req *http.Request) (*http.Response, error) {
+		params := metav1alpha1.UpdatePullRequestCommentParam{}
+		if err := json.NewDecoder(req.Body).Decode(&params); err != nil {
+			return nil, err
+		}
+		return httpmock.NewJsonResponse(http.StatusOK, metav1alpha1.GitPullRequestNote{
+			Spec: metav1alpha1.GitPullRequestNoteSpec{ID: 2, Body: params.Body},
+		})
+	})
+	httpmock.RegisterResponder("DELETE", notesUrl+"/2", httpmock.NewStringResponder(http.StatusOK, ""))
+
+	RESTClient := resty.New()
+	httpmock.ActivateNonDefault(RESTClient.GetClient())
+	client := NewPluginClient(ClientOpts(RESTClient))
+
+	url, _ := apis.ParseURL("https://example.com/api/v1")
+	baseURL := &duckv1.Addressable{URL: url}
+	option := metav1alpha1.GitPullRequestOption{
+		GitRepo: metav1alpha1.GitRepo{Project: "katanomi", Repository: "pkg"},
+		Index:   1,
+	}
+	prClient := client.GitPullRequest(Meta{}, corev1.Secret{})
+
+	position := &metav1alpha1.GitPullRequestNotePosition{Path: "main.go", Line: 10, OldLine: 8, CommitSHA: "abc"}
+	note, err := prClient.CreateNote(context.Background(), baseURL, metav1alpha1.CreatePullRequestCommentPayload{
+		GitRepo:                       option.GitRepo,
+		Index:                         option.Index,
+		CreatePullRequestCommentParam: metav1alpha1.CreatePullRequestCommentParam{Body: "nit", Position: position},
+	})
+	g.Expect(err).To(BeNil())
+	g.Expect(note.Spec.Position).To(Equal(position))
+
+	list, err := prClient.ListNote(context.Background(), baseURL, option, ListOpts(metav1alpha1.ListOptions{Page: 2, ItemsPerPage: 1}))
+	g.Expect(err).To(BeNil())
+	g.Expect(list.TotalItems).To(Equal(3))
+	g.Expect(list.Items[0].Spec.ID).To(Equal(2))
+
+	commentOption := metav1alpha1.GitPullRequestCommentOption{GitPullRequestOption: option, CommentID: 2}
+	note, err = prClient.UpdateNote(context.Background(), baseURL, metav1alpha1.UpdatePullRequestCommentPayload{
+		GitPullRequestCommentOption:   commentOption,
+		UpdatePullRequestCommentParam: metav1alpha1.UpdatePullRequestCommentParam{Body: "updated"},
+	})
+	g.Expect(err).To(BeNil())
+	g.Expect(note.Spec.ID).To(Equal(2))
+	g.Expect(note.Spec.Body).To(Equal("updated"))
+
+	g.Expect(prClient.DeleteNote(context.Background(), baseURL, commentOption)).To(Succeed())
+	g.Expect(httpmock.GetCallCountInfo()["DELETE "+notesUrl+"/2"]).To(Equal(1))
+}
+
+func TestGitPullRequestNoteValidation(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	client := NewPluginClient()
+	option := metav1alpha1.GitPullRequestCommentOption{
+		GitPullRequestOption: metav1alpha1.GitPullRequestOption{
+			GitRepo: metav1alpha1.GitRepo{Project: "katanomi", Repository: "pkg"},
+			Index:   1,
+		},
+	}
+	err := client.GitPullRequest(Meta{}, corev1.Secret{}).DeleteNote(context.Background(), &duckv1.Addressable{}, option)
+
+	g.Expect(err).NotTo(BeNil())
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2021 The Katanomi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+
+	metav1alpha1 "github.com/katanomi/pkg/apis/meta/v1alpha1"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+// ClientGitRelease client for release
+type ClientGitRelease interface {
+	List(ctx context.Context, baseURL *duckv1.Addressable, repo metav1alpha1.GitRepo, options ...OptionFunc) (*metav1alpha1.GitReleaseList, error)
+	Create(ctx context.Context, baseURL *duckv1.Addressable, payload metav1alpha1.CreateReleasePayload, options ...OptionFunc) (*metav1alpha1.GitRelease, error)
+	UploadAsset(ctx context.Context, baseURL *duckv1.Addressable, option metav1alpha1.GitReleaseAssetOption, content io.Reader, options ...OptionFunc) (*metav1alpha1.GitReleaseAsset, error)
+}
+
+type gitRelease struct {
+	client Client
+	meta   Meta
+	secret corev1.Secret
+}
+
+func newGitRelease(client Client, meta Meta, secret corev1.Secret) ClientGitRelease {
+	return &gitRelease{
+		client: client,
+		meta:   meta,
+		secret: secret,
+	}
+}
+
+// List list release
+func (g *gitRelease) List(ctx context.Context, baseURL *duckv1.Addressable, repo metav1alpha1.GitRepo, options ...OptionFunc) (*metav1alpha1.GitReleaseList, error) {
+	list := &metav1alpha1.GitReleaseList{}
+	options = append(options, MetaOpts(g.meta), SecretOpts(g.secret), ResultOpts(list))
+	if repo.Repository == "" {
+		return nil, errors.New("repo is empty string")
+	}
+	uri := fmt.Sprintf("projects/%s/coderepositories/%s/releases", repo.Project, repo.Repository)
+	if err := g.client.Get(ctx, baseURL, uri, options...); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// Create create release
+func (g *gitRelease) Create(ctx context.Context, baseURL *duckv1.Addressable, payload metav1alpha1.CreateReleasePayload, options ...OptionFunc) (*metav1alpha1.GitRelease, error) {
+	releaseObj := &metav1alpha1.GitRelease{}
+	options = append(options, MetaOpts(g.meta), SecretOpts(g.secret), BodyOpts(payload.CreateReleaseParams), ResultOpts(releaseObj))
+	if payload.Repository == "" {
+		return nil, errors.New("repo is empty string")
+	} else if payload.Tag == "" {
+		return nil, errors.New("tag name is empty string")
+	}
+	uri := fmt.Sprintf("projects/%s/coderepositories/%s/releases", payload.Project, payload.Repository)
+	if err := g.client.Post(ctx, baseURL, uri, options...); err != nil {
+		return nil, err
+	}
+
+	return releaseObj, nil
+}
+
+// UploadAsset upload release asset, content is streamed to the plugin without buffering
+func (g *gitRelease) UploadAsset(ctx context.Context, baseURL *duckv1.Addressable, option metav1alpha1.GitReleaseAssetOption, content io.Reader, options ...OptionFunc) (*metav1alpha1.GitReleaseAsset, error) {
+	if option.Repository == "" {
+		return nil, errors.New("repo is empty string")
+	} else if option.Release == "" {
+		return nil, errors.New("release is empty string")
+	} else if option.Name == "" {
+		return nil, errors.New("asset name is empty string")
+	}
+
+	contentType := option.ContentType
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+	query := map[string]string{"name": option.Name}
+	if option.Size > 0 {
+		query["size"] = strconv.FormatInt(option.Size, 10)
+	}
+
+	assetObj := &metav1alpha1.GitReleaseAsset{}
+	options = append(options, MetaOpts(g.meta), SecretOpts(g.secret), QueryOpts(query),
+		HeaderOpts("Content-Type", contentType), BodyOpts(content), ResultOpts(assetObj))
+	uri := fmt.Sprintf("projects/%s/coderepositories/%s/releases/%s/assets", option.Project, option.Repository, option.Release)
+	if err := g.client.Post(ctx, baseURL, uri, options...); err != nil {
+		return nil, err
+	}
+
+	return assetObj, nil
+}
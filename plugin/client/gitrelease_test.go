@@ -0,0 +1,90 @@
+/*
+Copyright 2021 The Katanomi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/jarcoal/httpmock"
+	metav1alpha1 "github.com/katanomi/pkg/apis/meta/v1alpha1"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+func TestGitReleaseUploadAsset(t *testing.T) {
+	g := NewGomegaWithT(t)
+	httpmock.Reset()
+
+	fakeUrl := "https://example.com/api/v1/projects/katanomi/coderepositories/pkg/releases/1/assets"
+	httpmock.RegisterResponder("POST", fakeUrl, func(req *http.Request) (*http.Response, error) {
+		data, err := ioutil.ReadAll(req.Body)
+		if err != nil {
+			return nil, err
+		}
+		asset := metav1alpha1.GitReleaseAsset{
+			Spec: metav1alpha1.GitReleaseAssetSpec{
+				Name:        req.URL.Query().Get("name"),
+				ContentType: req.Header.Get("Content-Type"),
+				Size:        int64(len(data)),
+				DownloadURL: string(data),
+			},
+		}
+		return httpmock.NewJsonResponse(http.StatusOK, asset)
+	})
+
+	RESTClient := resty.New()
+	httpmock.ActivateNonDefault(RESTClient.GetClient())
+	client := NewPluginClient(ClientOpts(RESTClient))
+
+	url, _ := apis.ParseURL("https://example.com/api/v1")
+	option := metav1alpha1.GitReleaseAssetOption{
+		GitReleaseOption: metav1alpha1.GitReleaseOption{
+			GitRepo: metav1alpha1.GitRepo{Project: "katanomi", Repository: "pkg"},
+			Release: "1",
+		},
+		Name: "pkg-linux-amd64",
+	}
+	asset, err := client.GitRelease(Meta{}, corev1.Secret{}).UploadAsset(context.Background(), &duckv1.Addressable{URL: url}, option, strings.NewReader("binary"))
+
+	g.Expect(err).To(BeNil())
+	g.Expect(asset.Spec.Name).To(Equal("pkg-linux-amd64"))
+	g.Expect(asset.Spec.ContentType).To(Equal("application/octet-stream"))
+	g.Expect(asset.Spec.Size).To(Equal(int64(6)))
+	g.Expect(asset.Spec.DownloadURL).To(Equal("binary"))
+}
+
+func TestGitReleaseUploadAssetValidation(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	client := NewPluginClient()
+	option := metav1alpha1.GitReleaseAssetOption{
+		GitReleaseOption: metav1alpha1.GitReleaseOption{
+			GitRepo: metav1alpha1.GitRepo{Project: "katanomi", Repository: "pkg"},
+			Release: "1",
+		},
+	}
+	_, err := client.GitRelease(Meta{}, corev1.Secret{}).UploadAsset(context.Background(), &duckv1.Addressable{}, option, strings.NewReader("binary"))
+
+	g.Expect(err).NotTo(BeNil())
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2021 The Katanomi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+
+	metav1alpha1 "github.com/katanomi/pkg/apis/meta/v1alpha1"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+// ClientGitTag client for tag
+type ClientGitTag interface {
+	List(ctx context.Context, baseURL *duckv1.Addressable, repo metav1alpha1.GitRepo, options ...OptionFunc) (*metav1alpha1.GitTagList, error)
+	Create(ctx context.Context, baseURL *duckv1.Addressable, payload metav1alpha1.CreateTagPayload, options ...OptionFunc) (*metav1alpha1.GitTag, error)
+}
+
+type gitTag struct {
+	client Client
+	meta   Meta
+	secret corev1.Secret
+}
+
+func newGitTag(client Client, meta Meta, secret corev1.Secret) ClientGitTag {
+	return &gitTag{
+		client: client,
+		meta:   meta,
+		secret: secret,
+	}
+}
+
+// List list tag
+func (g *gitTag) List(ctx context.Context, baseURL *duckv1.Addressable, repo metav1alpha1.GitRepo, options ...OptionFunc) (*metav1alpha1.GitTagList, error) {
+	list := &metav1alpha1.GitTagList{}
+	options = append(options, MetaOpts(g.meta), SecretOpts(g.secret), ResultOpts(list))
+	if repo.Repository == "" {
+		return nil, errors.New("repo is empty string")
+	}
+	uri := fmt.Sprintf("projects/%s/coderepositories/%s/tags", repo.Project, repo.Repository)
+	if err := g.client.Get(ctx, baseURL, uri, options...); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// Create create tag
+func (g *gitTag) Create(ctx context.Context, baseURL *duckv1.Addressable, payload metav1alpha1.CreateTagPayload, options ...OptionFunc) (*metav1alpha1.GitTag, error) {
+	tagObj := &metav1alpha1.GitTag{}
+	options = append(options, MetaOpts(g.meta), SecretOpts(g.secret), BodyOpts(payload.CreateTagParams), ResultOpts(tagObj))
+	if payload.Repository == "" {
+		return nil, errors.New("repo is empty string")
+	} else if payload.Tag == "" {
+		return nil, errors.New("tag name is empty string")
+	}
+	uri := fmt.Sprintf("projects/%s/coderepositories/%s/tags", payload.Project, payload.Repository)
+	if err := g.client.Post(ctx, baseURL, uri, options...); err != nil {
+		return nil, err
+	}
+
+	return tagObj, nil
+}
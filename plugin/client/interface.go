@@ -18,10 +18,12 @@ package client
 
 import (
 	"context"
+	"io"
 
 	"github.com/emicklei/go-restful/v3"
 	metav1alpha1 "github.com/katanomi/pkg/apis/meta/v1alpha1"
 	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	"knative.dev/pkg/apis"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
 
@@ -73,18 +75,86 @@ type ProjectCreator interface {
 	CreateProject(ctx context.Context, project *metav1alpha1.Project) (*metav1alpha1.Project, error)
 }
 
+// ProjectUpdater update project api, covers visibility, description and properties
+type ProjectUpdater interface {
+	Interface
+	UpdateProject(ctx context.Context, project *metav1alpha1.Project) (*metav1alpha1.Project, error)
+}
+
+// ProjectDeleter delete project api
+type ProjectDeleter interface {
+	Interface
+	DeleteProject(ctx context.Context, id string) error
+}
+
+// ProjectMemberLister list project member api
+type ProjectMemberLister interface {
+	Interface
+	ListProjectMembers(ctx context.Context, id string, option metav1alpha1.ListOptions) (*metav1alpha1.ProjectMemberList, error)
+}
+
+// ProjectMemberAdder add project member api
+type ProjectMemberAdder interface {
+	Interface
+	AddProjectMember(ctx context.Context, id string, member *metav1alpha1.ProjectMember) (*metav1alpha1.ProjectMember, error)
+}
+
+// ProjectMemberRemover remove project member api
+type ProjectMemberRemover interface {
+	Interface
+	RemoveProjectMember(ctx context.Context, id string, subject rbacv1.Subject) error
+}
+
+// UserGetter get the user behind the request auth, useful to validate credentials
+type UserGetter interface {
+	Interface
+	GetCurrentUser(ctx context.Context) (*metav1alpha1.User, error)
+}
+
+// UserLister search users by name or email in ListOptions.Search
+type UserLister interface {
+	Interface
+	ListUsers(ctx context.Context, option metav1alpha1.ListOptions) (*metav1alpha1.UserList, error)
+}
+
 // ResourceLister list resource api
 type ResourceLister interface {
 	Interface
 	ListResources(ctx context.Context, option metav1alpha1.ListOptions) (*metav1alpha1.ResourceList, error)
 }
 
+// ResourceGetter get resource api
+type ResourceGetter interface {
+	Interface
+	GetResource(ctx context.Context, params metav1alpha1.ResourceOptions) (*metav1alpha1.Resource, error)
+}
+
+// ResourceChildrenLister list children of a resource api, e.g
+// repositories of a project or artifacts of a repository.
+// Children carry their own SubType so they can be browsed further
+type ResourceChildrenLister interface {
+	Interface
+	ListResourceChildren(ctx context.Context, params metav1alpha1.ResourceOptions, option metav1alpha1.ListOptions) (*metav1alpha1.ResourceList, error)
+}
+
 // RepositoryLister list repository
 type RepositoryLister interface {
 	Interface
 	ListRepositories(ctx context.Context, params metav1alpha1.RepositoryOptions, option metav1alpha1.ListOptions) (*metav1alpha1.RepositoryList, error)
 }
 
+// RepositoryCreator create repository
+type RepositoryCreator interface {
+	Interface
+	CreateRepository(ctx context.Context, params metav1alpha1.RepositoryOptions, option metav1alpha1.RepositoryCreateOptions) (*metav1alpha1.Repository, error)
+}
+
+// RepositoryDeleter delete repository
+type RepositoryDeleter interface {
+	Interface
+	DeleteRepository(ctx context.Context, params metav1alpha1.RepositoryOptions, repository string) error
+}
+
 // ArtifactLister list artifact
 type ArtifactLister interface {
 	Interface
@@ -103,12 +173,30 @@ type ArtifactDeleter interface {
 	DeleteArtifact(ctx context.Context, params metav1alpha1.ArtifactOptions) error
 }
 
+// ArtifactTagLister list artifact tags of a repository or of an artifact when Artifact is set
+type ArtifactTagLister interface {
+	Interface
+	ListArtifactTags(ctx context.Context, params metav1alpha1.ArtifactOptions, option metav1alpha1.ListOptions) (*metav1alpha1.ArtifactTagList, error)
+}
+
+// ArtifactCopier copy or promote artifact to another project or repository
+type ArtifactCopier interface {
+	Interface
+	CopyArtifact(ctx context.Context, params metav1alpha1.ArtifactOptions, option metav1alpha1.ArtifactCopyOptions) (*metav1alpha1.Artifact, error)
+}
+
 // ScanImage scan image
 type ScanImage interface {
 	Interface
 	ScanImage(ctx context.Context, params metav1alpha1.ArtifactOptions) error
 }
 
+// ScanReportGetter get scan status and vulnerability report of an artifact
+type ScanReportGetter interface {
+	Interface
+	GetScanReport(ctx context.Context, params metav1alpha1.ArtifactOptions, option metav1alpha1.ScanReportOptions) (*metav1alpha1.ScanReport, error)
+}
+
 // WebhookRegister used to register and manage webhooks
 type WebhookRegister interface {
 	// Use the methods below to manage webhooks in the target platform
@@ -132,12 +220,48 @@ type WebhookReceiver interface {
 	ReceiveWebhook(ctx context.Context, req *restful.Request, secret string) (cloudevent.Event, error)
 }
 
+// WebhookSecretGetter looks up the secret used to validate webhook requests of an integration
+// The request body can be read safely, it will be restored before calling ReceiveWebhook
+type WebhookSecretGetter interface {
+	GetWebhookSecret(ctx context.Context, req *restful.Request) (string, error)
+}
+
 // GitPullRequestCommentCreator create pull request comment functions
 type GitPullRequestCommentCreator interface {
 	Interface
 	CreatePullRequestComment(ctx context.Context, option metav1alpha1.CreatePullRequestCommentPayload) (metav1alpha1.GitPullRequestNote, error)
 }
 
+// GitPullRequestCommentLister list pull request comment functions
+type GitPullRequestCommentLister interface {
+	Interface
+	ListPullRequestComment(ctx context.Context, option metav1alpha1.GitPullRequestOption, listOption metav1alpha1.ListOptions) (metav1alpha1.GitPullRequestNoteList, error)
+}
+
+// GitPullRequestCommentUpdater update pull request comment functions
+type GitPullRequestCommentUpdater interface {
+	Interface
+	UpdatePullRequestComment(ctx context.Context, payload metav1alpha1.UpdatePullRequestCommentPayload) (metav1alpha1.GitPullRequestNote, error)
+}
+
+// GitPullRequestCommentDeleter delete pull request comment functions
+type GitPullRequestCommentDeleter interface {
+	Interface
+	DeletePullRequestComment(ctx context.Context, option metav1alpha1.GitPullRequestCommentOption) error
+}
+
+// GitPullRequestReviewLister list pr reviews and approvals
+type GitPullRequestReviewLister interface {
+	Interface
+	ListPullRequestReview(ctx context.Context, option metav1alpha1.GitPullRequestOption, listOption metav1alpha1.ListOptions) (metav1alpha1.GitPullRequestReviewList, error)
+}
+
+// GitPullRequestReviewCreator submit a pr review
+type GitPullRequestReviewCreator interface {
+	Interface
+	CreatePullRequestReview(ctx context.Context, payload metav1alpha1.CreatePullRequestReviewPayload) (metav1alpha1.GitPullRequestReview, error)
+}
+
 // GitPullRequestHandler list, get and create pr function
 type GitPullRequestHandler interface {
 	Interface
@@ -146,12 +270,72 @@ type GitPullRequestHandler interface {
 	CreatePullRequest(ctx context.Context, payload metav1alpha1.CreatePullRequestPayload) (metav1alpha1.GitPullRequest, error)
 }
 
+// GitPullRequestMerger merge pr
+type GitPullRequestMerger interface {
+	Interface
+	MergePullRequest(ctx context.Context, payload metav1alpha1.MergePullRequestPayload) (metav1alpha1.GitPullRequest, error)
+}
+
+// GitPullRequestCloser close pr
+type GitPullRequestCloser interface {
+	Interface
+	ClosePullRequest(ctx context.Context, option metav1alpha1.GitPullRequestOption) (metav1alpha1.GitPullRequest, error)
+}
+
+// GitPullRequestReopener reopen a closed pr
+type GitPullRequestReopener interface {
+	Interface
+	ReopenPullRequest(ctx context.Context, option metav1alpha1.GitPullRequestOption) (metav1alpha1.GitPullRequest, error)
+}
+
+// GitPullRequestUpdater update pr title, description and target branch
+type GitPullRequestUpdater interface {
+	Interface
+	UpdatePullRequest(ctx context.Context, payload metav1alpha1.UpdatePullRequestPayload) (metav1alpha1.GitPullRequest, error)
+}
+
+// GitPullRequestCommitLister list commits of a pr
+type GitPullRequestCommitLister interface {
+	Interface
+	ListPullRequestCommits(ctx context.Context, option metav1alpha1.GitPullRequestOption, listOption metav1alpha1.ListOptions) (metav1alpha1.GitCommitList, error)
+}
+
+// GitPullRequestFileLister list changed files of a pr
+type GitPullRequestFileLister interface {
+	Interface
+	ListPullRequestFiles(ctx context.Context, option metav1alpha1.GitPullRequestOption, listOption metav1alpha1.ListOptions) (metav1alpha1.GitChangedFileList, error)
+}
+
 // GitCommitGetter get git commit
 type GitCommitGetter interface {
 	Interface
 	GetGitCommit(ctx context.Context, option metav1alpha1.GitCommitOption) (metav1alpha1.GitCommit, error)
 }
 
+// GitCommitLister List git commit
+type GitCommitLister interface {
+	Interface
+	ListGitCommit(ctx context.Context, option metav1alpha1.GitCommitListOption, listOption metav1alpha1.ListOptions) (metav1alpha1.GitCommitList, error)
+}
+
+// GitCommitStatusLister list commit statuses
+type GitCommitStatusLister interface {
+	Interface
+	ListGitCommitStatus(ctx context.Context, option metav1alpha1.GitCommitOption, listOption metav1alpha1.ListOptions) (metav1alpha1.GitCommitStatusList, error)
+}
+
+// GitCommitStatusCreator report a commit status
+type GitCommitStatusCreator interface {
+	Interface
+	CreateGitCommitStatus(ctx context.Context, payload metav1alpha1.CreateCommitStatusPayload) (metav1alpha1.GitCommitStatus, error)
+}
+
+// GitCompareGetter compare two refs, returns commits and changed files between them
+type GitCompareGetter interface {
+	Interface
+	GetGitCompare(ctx context.Context, option metav1alpha1.GitCompareOption) (metav1alpha1.GitCompare, error)
+}
+
 // GitBranchLister List git branch
 type GitBranchLister interface {
 	Interface
@@ -164,18 +348,116 @@ type GitBranchCreator interface {
 	CreateGitBranch(ctx context.Context, payload metav1alpha1.CreateBranchPayload) (metav1alpha1.GitBranch, error)
 }
 
+// GitBranchDeleter delete git branch
+type GitBranchDeleter interface {
+	Interface
+	DeleteGitBranch(ctx context.Context, option metav1alpha1.GitBranchOption) error
+}
+
+// GitBranchProtectionHandler set and remove branch protection rules
+type GitBranchProtectionHandler interface {
+	Interface
+	ProtectGitBranch(ctx context.Context, payload metav1alpha1.BranchProtectionPayload) (metav1alpha1.GitBranch, error)
+	UnprotectGitBranch(ctx context.Context, option metav1alpha1.GitBranchOption) (metav1alpha1.GitBranch, error)
+}
+
+// GitTagLister List git tag
+type GitTagLister interface {
+	Interface
+	ListGitTag(ctx context.Context, repoOption metav1alpha1.GitRepo, option metav1alpha1.ListOptions) (metav1alpha1.GitTagList, error)
+}
+
+// GitTagCreator create git tag
+type GitTagCreator interface {
+	Interface
+	CreateGitTag(ctx context.Context, payload metav1alpha1.CreateTagPayload) (metav1alpha1.GitTag, error)
+}
+
+// GitCodeSearcher search code across repositories
+type GitCodeSearcher interface {
+	Interface
+	SearchGitCode(ctx context.Context, option metav1alpha1.GitCodeSearchOption, listOption metav1alpha1.ListOptions) (metav1alpha1.GitCodeSearchResultList, error)
+}
+
+// ReleaseLister list releases of a repository
+type ReleaseLister interface {
+	Interface
+	ListReleases(ctx context.Context, repoOption metav1alpha1.GitRepo, option metav1alpha1.ListOptions) (metav1alpha1.GitReleaseList, error)
+}
+
+// ReleaseCreator create a release
+type ReleaseCreator interface {
+	Interface
+	CreateRelease(ctx context.Context, payload metav1alpha1.CreateReleasePayload) (metav1alpha1.GitRelease, error)
+}
+
+// ReleaseAssetUploader upload an asset to a release.
+// content is streamed from the request and should not be buffered in memory
+type ReleaseAssetUploader interface {
+	Interface
+	UploadReleaseAsset(ctx context.Context, option metav1alpha1.GitReleaseAssetOption, content io.Reader) (metav1alpha1.GitReleaseAsset, error)
+}
+
 // GitRepoFileGetter used to get a file content
 type GitRepoFileGetter interface {
 	Interface
 	GetGitRepoFile(ctx context.Context, option metav1alpha1.GitRepoFileOption) (metav1alpha1.GitRepoFile, error)
 }
 
+// GitRepoTreeLister used to list entries of a directory
+type GitRepoTreeLister interface {
+	Interface
+	ListGitRepoTree(ctx context.Context, option metav1alpha1.GitRepoTreeOption, listOption metav1alpha1.ListOptions) (metav1alpha1.GitRepoTreeEntryList, error)
+}
+
 // GitRepoFileCreator used to create a file, gogs don't support
 type GitRepoFileCreator interface {
 	Interface
 	CreateGitRepoFile(ctx context.Context, payload metav1alpha1.CreateRepoFilePayload) (metav1alpha1.GitCommit, error)
 }
 
+// GitRepoFileUpdater used to update an existing file
+type GitRepoFileUpdater interface {
+	Interface
+	UpdateGitRepoFile(ctx context.Context, payload metav1alpha1.UpdateRepoFilePayload) (metav1alpha1.GitCommit, error)
+}
+
+// GitRepoFileDeleter used to delete an existing file
+type GitRepoFileDeleter interface {
+	Interface
+	DeleteGitRepoFile(ctx context.Context, payload metav1alpha1.DeleteRepoFilePayload) (metav1alpha1.GitCommit, error)
+}
+
+// IssueLister list issues of a project or repository
+type IssueLister interface {
+	Interface
+	ListIssues(ctx context.Context, params metav1alpha1.IssueOptions, option metav1alpha1.ListOptions) (*metav1alpha1.IssueList, error)
+}
+
+// IssueGetter get an issue
+type IssueGetter interface {
+	Interface
+	GetIssue(ctx context.Context, params metav1alpha1.IssueOptions) (*metav1alpha1.Issue, error)
+}
+
+// IssueCreator create an issue
+type IssueCreator interface {
+	Interface
+	CreateIssue(ctx context.Context, params metav1alpha1.IssueOptions, payload metav1alpha1.CreateIssueParams) (*metav1alpha1.Issue, error)
+}
+
+// IssueUpdater update an issue, also used to close or reopen it
+type IssueUpdater interface {
+	Interface
+	UpdateIssue(ctx context.Context, params metav1alpha1.IssueOptions, payload metav1alpha1.UpdateIssueParams) (*metav1alpha1.Issue, error)
+}
+
+// IssueCommentCreator create a comment in an issue
+type IssueCommentCreator interface {
+	Interface
+	CreateIssueComment(ctx context.Context, params metav1alpha1.IssueOptions, payload metav1alpha1.CreateIssueCommentParams) (*metav1alpha1.IssueComment, error)
+}
+
 // Client inteface for PluginClient, client code shoud use the interface
 // as dependency
 type Client interface {
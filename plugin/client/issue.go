@@ -0,0 +1,156 @@
+/*
+Copyright 2021 The Katanomi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	metav1alpha1 "github.com/katanomi/pkg/apis/meta/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+// ClientIssue client for issues
+type ClientIssue interface {
+	List(ctx context.Context, baseURL *duckv1.Addressable, params metav1alpha1.IssueOptions, options ...OptionFunc) (*metav1alpha1.IssueList, error)
+	Get(ctx context.Context, baseURL *duckv1.Addressable, params metav1alpha1.IssueOptions, options ...OptionFunc) (*metav1alpha1.Issue, error)
+	Create(ctx context.Context, baseURL *duckv1.Addressable, params metav1alpha1.IssueOptions, payload metav1alpha1.CreateIssueParams, options ...OptionFunc) (*metav1alpha1.Issue, error)
+	Update(ctx context.Context, baseURL *duckv1.Addressable, params metav1alpha1.IssueOptions, payload metav1alpha1.UpdateIssueParams, options ...OptionFunc) (*metav1alpha1.Issue, error)
+	CreateComment(ctx context.Context, baseURL *duckv1.Addressable, params metav1alpha1.IssueOptions, payload metav1alpha1.CreateIssueCommentParams, options ...OptionFunc) (*metav1alpha1.IssueComment, error)
+}
+
+type issue struct {
+	client Client
+	meta   Meta
+	secret corev1.Secret
+}
+
+func newIssue(client Client, meta Meta, secret corev1.Secret) ClientIssue {
+	return &issue{
+		client: client,
+		meta:   meta,
+		secret: secret,
+	}
+}
+
+// List list issues using plugin
+func (i *issue) List(ctx context.Context, baseURL *duckv1.Addressable, params metav1alpha1.IssueOptions, options ...OptionFunc) (*metav1alpha1.IssueList, error) {
+	uri, err := issuesURI(params)
+	if err != nil {
+		return nil, err
+	}
+
+	list := &metav1alpha1.IssueList{}
+	options = append(options, MetaOpts(i.meta), SecretOpts(i.secret), issueRepositoryOpts(params), ResultOpts(list))
+	if err := i.client.Get(ctx, baseURL, uri, options...); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+// Get get issue using plugin
+func (i *issue) Get(ctx context.Context, baseURL *duckv1.Addressable, params metav1alpha1.IssueOptions, options ...OptionFunc) (*metav1alpha1.Issue, error) {
+	uri, err := issueURI(params)
+	if err != nil {
+		return nil, err
+	}
+
+	issueObj := &metav1alpha1.Issue{}
+	options = append(options, MetaOpts(i.meta), SecretOpts(i.secret), issueRepositoryOpts(params), ResultOpts(issueObj))
+	if err := i.client.Get(ctx, baseURL, uri, options...); err != nil {
+		return nil, err
+	}
+
+	return issueObj, nil
+}
+
+// Create create issue using plugin
+func (i *issue) Create(ctx context.Context, baseURL *duckv1.Addressable, params metav1alpha1.IssueOptions, payload metav1alpha1.CreateIssueParams, options ...OptionFunc) (*metav1alpha1.Issue, error) {
+	uri, err := issuesURI(params)
+	if err != nil {
+		return nil, err
+	}
+
+	issueObj := &metav1alpha1.Issue{}
+	options = append(options, MetaOpts(i.meta), SecretOpts(i.secret), issueRepositoryOpts(params), BodyOpts(payload), ResultOpts(issueObj))
+	if err := i.client.Post(ctx, baseURL, uri, options...); err != nil {
+		return nil, err
+	}
+
+	return issueObj, nil
+}
+
+// Update update issue using plugin
+func (i *issue) Update(ctx context.Context, baseURL *duckv1.Addressable, params metav1alpha1.IssueOptions, payload metav1alpha1.UpdateIssueParams, options ...OptionFunc) (*metav1alpha1.Issue, error) {
+	uri, err := issueURI(params)
+	if err != nil {
+		return nil, err
+	}
+
+	issueObj := &metav1alpha1.Issue{}
+	options = append(options, MetaOpts(i.meta), SecretOpts(i.secret), issueRepositoryOpts(params), BodyOpts(payload), ResultOpts(issueObj))
+	if err := i.client.Put(ctx, baseURL, uri, options...); err != nil {
+		return nil, err
+	}
+
+	return issueObj, nil
+}
+
+// CreateComment create issue comment using plugin
+func (i *issue) CreateComment(ctx context.Context, baseURL *duckv1.Addressable, params metav1alpha1.IssueOptions, payload metav1alpha1.CreateIssueCommentParams, options ...OptionFunc) (*metav1alpha1.IssueComment, error) {
+	uri, err := issueURI(params)
+	if err != nil {
+		return nil, err
+	}
+
+	comment := &metav1alpha1.IssueComment{}
+	options = append(options, MetaOpts(i.meta), SecretOpts(i.secret), issueRepositoryOpts(params), BodyOpts(payload), ResultOpts(comment))
+	if err := i.client.Post(ctx, baseURL, uri+"/comments", options...); err != nil {
+		return nil, err
+	}
+
+	return comment, nil
+}
+
+func issuesURI(params metav1alpha1.IssueOptions) (string, error) {
+	if params.Project == "" {
+		return "", errors.New("project is empty string")
+	}
+	return fmt.Sprintf("projects/%s/issues", params.Project), nil
+}
+
+func issueURI(params metav1alpha1.IssueOptions) (string, error) {
+	uri, err := issuesURI(params)
+	if err != nil {
+		return "", err
+	}
+	if params.Issue == "" {
+		return "", errors.New("issue is empty string")
+	}
+	return uri + "/" + params.Issue, nil
+}
+
+func issueRepositoryOpts(params metav1alpha1.IssueOptions) OptionFunc {
+	query := map[string]string{}
+	if params.Repository != "" {
+		query["repository"] = params.Repository
+	}
+	return QueryOpts(query)
+}
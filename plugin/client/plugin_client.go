@@ -189,6 +189,21 @@ func (p *PluginClient) GitBranch(meta Meta, secret corev1.Secret) ClientGitBranc
 	return newGitBranch(p, meta, secret)
 }
 
+// GitTag get tag client
+func (p *PluginClient) GitTag(meta Meta, secret corev1.Secret) ClientGitTag {
+	return newGitTag(p, meta, secret)
+}
+
+// GitCodeSearch get code search client
+func (p *PluginClient) GitCodeSearch(meta Meta, secret corev1.Secret) ClientGitCodeSearch {
+	return newGitCodeSearch(p, meta, secret)
+}
+
+// GitRelease get release client
+func (p *PluginClient) GitRelease(meta Meta, secret corev1.Secret) ClientGitRelease {
+	return newGitRelease(p, meta, secret)
+}
+
 // GitContent get content client
 func (p *PluginClient) GitContent(meta Meta, secret corev1.Secret) ClientGitContent {
 	return newGitContent(p, meta, secret)
@@ -203,3 +218,38 @@ func (p *PluginClient) GitPullRequest(meta Meta, secret corev1.Secret) ClientGit
 func (p *PluginClient) GitCommit(meta Meta, secret corev1.Secret) ClientGitCommit {
 	return newGitCommit(p, meta, secret)
 }
+
+// GitCommitStatus get commit status client
+func (p *PluginClient) GitCommitStatus(meta Meta, secret corev1.Secret) ClientGitCommitStatus {
+	return newGitCommitStatus(p, meta, secret)
+}
+
+// Webhook get webhook client
+func (p *PluginClient) Webhook(meta Meta, secret corev1.Secret) ClientWebhook {
+	return newWebhook(p, meta, secret)
+}
+
+// Resource get resource client
+func (p *PluginClient) Resource(meta Meta, secret corev1.Secret) ClientResource {
+	return newResource(p, meta, secret)
+}
+
+// Repository get repository client
+func (p *PluginClient) Repository(meta Meta, secret corev1.Secret) ClientRepository {
+	return newRepository(p, meta, secret)
+}
+
+// Artifact get artifact client
+func (p *PluginClient) Artifact(meta Meta, secret corev1.Secret) ClientArtifact {
+	return newArtifact(p, meta, secret)
+}
+
+// Issue get issue client
+func (p *PluginClient) Issue(meta Meta, secret corev1.Secret) ClientIssue {
+	return newIssue(p, meta, secret)
+}
+
+// User get user client
+func (p *PluginClient) User(meta Meta, secret corev1.Secret) ClientUser {
+	return newUser(p, meta, secret)
+}
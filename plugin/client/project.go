@@ -18,9 +18,11 @@ package client
 
 import (
 	"context"
+	"errors"
 
 	metav1alpha1 "github.com/katanomi/pkg/apis/meta/v1alpha1"
 	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
 )
 
@@ -28,6 +30,11 @@ type ClientProject interface {
 	List(ctx context.Context, baseURL *duckv1.Addressable, options ...OptionFunc) (*metav1alpha1.ProjectList, error)
 	Create(ctx context.Context, baseURL *duckv1.Addressable, project *metav1alpha1.Project, options ...OptionFunc) (*metav1alpha1.Project, error)
 	Get(ctx context.Context, baseURL *duckv1.Addressable, id string, options ...OptionFunc) (*metav1alpha1.Project, error)
+	Update(ctx context.Context, baseURL *duckv1.Addressable, project *metav1alpha1.Project, options ...OptionFunc) (*metav1alpha1.Project, error)
+	Delete(ctx context.Context, baseURL *duckv1.Addressable, id string, options ...OptionFunc) error
+	ListMembers(ctx context.Context, baseURL *duckv1.Addressable, id string, options ...OptionFunc) (*metav1alpha1.ProjectMemberList, error)
+	AddMember(ctx context.Context, baseURL *duckv1.Addressable, id string, member *metav1alpha1.ProjectMember, options ...OptionFunc) (*metav1alpha1.ProjectMember, error)
+	RemoveMember(ctx context.Context, baseURL *duckv1.Addressable, id string, subject rbacv1.Subject, options ...OptionFunc) error
 }
 
 type project struct {
@@ -76,3 +83,61 @@ func (p *project) Get(ctx context.Context, baseURL *duckv1.Addressable, id strin
 
 	return resp, nil
 }
+
+// Update update project using plugin
+func (p *project) Update(ctx context.Context, baseURL *duckv1.Addressable, project *metav1alpha1.Project, options ...OptionFunc) (*metav1alpha1.Project, error) {
+	if project == nil || project.Name == "" {
+		return nil, errors.New("project name is empty string")
+	}
+
+	resp := &metav1alpha1.Project{}
+	options = append(options, MetaOpts(p.meta), SecretOpts(p.secret), BodyOpts(project), ResultOpts(resp))
+	if err := p.client.Put(ctx, baseURL, "projects/"+project.Name, options...); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// Delete delete project using plugin
+func (p *project) Delete(ctx context.Context, baseURL *duckv1.Addressable, id string, options ...OptionFunc) error {
+	if id == "" {
+		return errors.New("project id is empty string")
+	}
+
+	options = append(options, MetaOpts(p.meta), SecretOpts(p.secret))
+	return p.client.Delete(ctx, baseURL, "projects/"+id, options...)
+}
+
+// ListMembers list project members using plugin
+func (p *project) ListMembers(ctx context.Context, baseURL *duckv1.Addressable, id string, options ...OptionFunc) (*metav1alpha1.ProjectMemberList, error) {
+	list := &metav1alpha1.ProjectMemberList{}
+
+	options = append(options, MetaOpts(p.meta), SecretOpts(p.secret), ResultOpts(list))
+	if err := p.client.Get(ctx, baseURL, "projects/"+id+"/members", options...); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+// AddMember add project member using plugin
+func (p *project) AddMember(ctx context.Context, baseURL *duckv1.Addressable, id string, member *metav1alpha1.ProjectMember, options ...OptionFunc) (*metav1alpha1.ProjectMember, error) {
+	resp := &metav1alpha1.ProjectMember{}
+	options = append(options, MetaOpts(p.meta), SecretOpts(p.secret), BodyOpts(member), ResultOpts(resp))
+	if err := p.client.Post(ctx, baseURL, "projects/"+id+"/members", options...); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// RemoveMember remove project member using plugin
+func (p *project) RemoveMember(ctx context.Context, baseURL *duckv1.Addressable, id string, subject rbacv1.Subject, options ...OptionFunc) error {
+	if subject.Name == "" {
+		return errors.New("member is empty string")
+	}
+
+	options = append(options, MetaOpts(p.meta), SecretOpts(p.secret), QueryOpts(map[string]string{"member": subject.Name, "kind": subject.Kind}))
+	return p.client.Delete(ctx, baseURL, "projects/"+id+"/members", options...)
+}
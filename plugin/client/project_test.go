@@ -0,0 +1,92 @@
+/*
+Copyright 2021 The Katanomi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/jarcoal/httpmock"
+	metav1alpha1 "github.com/katanomi/pkg/apis/meta/v1alpha1"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+func TestProjectRemoveMember(t *testing.T) {
+	g := NewGomegaWithT(t)
+	httpmock.Reset()
+
+	removed := rbacv1.Subject{}
+	httpmock.RegisterResponder("DELETE", "https://example.com/api/v1/projects/1/members", func(req *http.Request) (*http.Response, error) {
+		removed.Name = req.URL.Query().Get("member")
+		removed.Kind = req.URL.Query().Get("kind")
+		return httpmock.NewStringResponse(http.StatusOK, ""), nil
+	})
+
+	RESTClient := resty.New()
+	httpmock.ActivateNonDefault(RESTClient.GetClient())
+	client := NewPluginClient(ClientOpts(RESTClient))
+
+	url, _ := apis.ParseURL("https://example.com/api/v1")
+	baseURL := &duckv1.Addressable{URL: url}
+	projectClient := client.Project(Meta{}, corev1.Secret{})
+
+	subject := rbacv1.Subject{Kind: rbacv1.GroupKind, Name: "group/subgroup"}
+	err := projectClient.RemoveMember(context.Background(), baseURL, "1", subject)
+	g.Expect(err).To(BeNil())
+	g.Expect(removed).To(Equal(subject))
+
+	err = projectClient.RemoveMember(context.Background(), baseURL, "1", rbacv1.Subject{Kind: rbacv1.UserKind})
+	g.Expect(err).NotTo(BeNil())
+}
+
+func TestProjectUpdateDeleteValidation(t *testing.T) {
+	g := NewGomegaWithT(t)
+	httpmock.Reset()
+
+	RESTClient := resty.New()
+	httpmock.ActivateNonDefault(RESTClient.GetClient())
+	client := NewPluginClient(ClientOpts(RESTClient))
+
+	url, _ := apis.ParseURL("https://example.com/api/v1")
+	baseURL := &duckv1.Addressable{URL: url}
+	projectClient := client.Project(Meta{}, corev1.Secret{})
+
+	_, err := projectClient.Update(context.Background(), baseURL, &metav1alpha1.Project{})
+	g.Expect(err).NotTo(BeNil())
+
+	_, err = projectClient.Update(context.Background(), baseURL, nil)
+	g.Expect(err).NotTo(BeNil())
+
+	err = projectClient.Delete(context.Background(), baseURL, "")
+	g.Expect(err).NotTo(BeNil())
+
+	g.Expect(httpmock.GetTotalCallCount()).To(Equal(0))
+
+	httpmock.RegisterResponder("PUT", "https://example.com/api/v1/projects/1", httpmock.NewJsonResponderOrPanic(http.StatusOK, metav1alpha1.Project{
+		ObjectMeta: metav1.ObjectMeta{Name: "1"},
+	}))
+	project, err := projectClient.Update(context.Background(), baseURL, &metav1alpha1.Project{ObjectMeta: metav1.ObjectMeta{Name: "1"}})
+	g.Expect(err).To(BeNil())
+	g.Expect(project.Name).To(Equal("1"))
+}
@@ -0,0 +1,76 @@
+/*
+Copyright 2021 The Katanomi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	metav1alpha1 "github.com/katanomi/pkg/apis/meta/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+// ClientRepository client for repositories
+type ClientRepository interface {
+	Create(ctx context.Context, baseURL *duckv1.Addressable, params metav1alpha1.RepositoryOptions, option metav1alpha1.RepositoryCreateOptions, options ...OptionFunc) (*metav1alpha1.Repository, error)
+	Delete(ctx context.Context, baseURL *duckv1.Addressable, params metav1alpha1.RepositoryOptions, repository string, options ...OptionFunc) error
+}
+
+type repository struct {
+	client Client
+	meta   Meta
+	secret corev1.Secret
+}
+
+func newRepository(client Client, meta Meta, secret corev1.Secret) ClientRepository {
+	return &repository{
+		client: client,
+		meta:   meta,
+		secret: secret,
+	}
+}
+
+// Create create repository using plugin
+func (r *repository) Create(ctx context.Context, baseURL *duckv1.Addressable, params metav1alpha1.RepositoryOptions, option metav1alpha1.RepositoryCreateOptions, options ...OptionFunc) (*metav1alpha1.Repository, error) {
+	if params.Project == "" {
+		return nil, errors.New("project is empty string")
+	} else if option.Name == "" {
+		return nil, errors.New("repository name is empty string")
+	}
+
+	resp := &metav1alpha1.Repository{}
+	options = append(options, MetaOpts(r.meta), SecretOpts(r.secret), BodyOpts(option), ResultOpts(resp))
+	if err := r.client.Post(ctx, baseURL, fmt.Sprintf("projects/%s/repositories", params.Project), options...); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// Delete delete repository using plugin
+func (r *repository) Delete(ctx context.Context, baseURL *duckv1.Addressable, params metav1alpha1.RepositoryOptions, repository string, options ...OptionFunc) error {
+	if params.Project == "" {
+		return errors.New("project is empty string")
+	} else if repository == "" {
+		return errors.New("repository is empty string")
+	}
+
+	options = append(options, MetaOpts(r.meta), SecretOpts(r.secret))
+	return r.client.Delete(ctx, baseURL, fmt.Sprintf("projects/%s/repositories/%s", params.Project, repository), options...)
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2021 The Katanomi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	metav1alpha1 "github.com/katanomi/pkg/apis/meta/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+// ClientResource client for resources
+type ClientResource interface {
+	Get(ctx context.Context, baseURL *duckv1.Addressable, params metav1alpha1.ResourceOptions, options ...OptionFunc) (*metav1alpha1.Resource, error)
+	ListChildren(ctx context.Context, baseURL *duckv1.Addressable, params metav1alpha1.ResourceOptions, options ...OptionFunc) (*metav1alpha1.ResourceList, error)
+}
+
+type resource struct {
+	client Client
+	meta   Meta
+	secret corev1.Secret
+}
+
+func newResource(client Client, meta Meta, secret corev1.Secret) ClientResource {
+	return &resource{
+		client: client,
+		meta:   meta,
+		secret: secret,
+	}
+}
+
+// Get get resource using plugin
+func (r *resource) Get(ctx context.Context, baseURL *duckv1.Addressable, params metav1alpha1.ResourceOptions, options ...OptionFunc) (*metav1alpha1.Resource, error) {
+	uri, err := resourceURI(params)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &metav1alpha1.Resource{}
+	options = append(options, MetaOpts(r.meta), SecretOpts(r.secret), QueryOpts(map[string]string{"id": params.ID}), ResultOpts(resp))
+	if err := r.client.Get(ctx, baseURL, uri, options...); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// ListChildren list children of a resource using plugin, pagination is set by ListOpts
+func (r *resource) ListChildren(ctx context.Context, baseURL *duckv1.Addressable, params metav1alpha1.ResourceOptions, options ...OptionFunc) (*metav1alpha1.ResourceList, error) {
+	uri, err := resourceURI(params)
+	if err != nil {
+		return nil, err
+	}
+
+	list := &metav1alpha1.ResourceList{}
+	options = append(options, MetaOpts(r.meta), SecretOpts(r.secret), QueryOpts(map[string]string{"id": params.ID}), ResultOpts(list))
+	if err := r.client.Get(ctx, baseURL, uri+"/children", options...); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func resourceURI(params metav1alpha1.ResourceOptions) (string, error) {
+	if params.SubType == "" {
+		return "", errors.New("resource subType is empty string")
+	} else if params.ID == "" {
+		return "", errors.New("resource id is empty string")
+	}
+	return fmt.Sprintf("resources/%s", params.SubType), nil
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2021 The Katanomi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/jarcoal/httpmock"
+	metav1alpha1 "github.com/katanomi/pkg/apis/meta/v1alpha1"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+func TestResourceNestedID(t *testing.T) {
+	g := NewGomegaWithT(t)
+	httpmock.Reset()
+
+	httpmock.RegisterResponder("GET", "https://example.com/api/v1/resources/ImageRepository", func(req *http.Request) (*http.Response, error) {
+		return httpmock.NewJsonResponse(http.StatusOK, metav1alpha1.Resource{
+			ObjectMeta: metav1.ObjectMeta{Name: req.URL.Query().Get("id")},
+		})
+	})
+	httpmock.RegisterResponder("GET", "https://example.com/api/v1/resources/ImageRepository/children", func(req *http.Request) (*http.Response, error) {
+		return httpmock.NewJsonResponse(http.StatusOK, metav1alpha1.ResourceList{
+			Items: []metav1alpha1.Resource{{ObjectMeta: metav1.ObjectMeta{Name: req.URL.Query().Get("id") + "/v1"}}},
+		})
+	})
+
+	RESTClient := resty.New()
+	httpmock.ActivateNonDefault(RESTClient.GetClient())
+	client := NewPluginClient(ClientOpts(RESTClient))
+
+	url, _ := apis.ParseURL("https://example.com/api/v1")
+	baseURL := &duckv1.Addressable{URL: url}
+	params := metav1alpha1.ResourceOptions{SubType: metav1alpha1.ResourceSubTypeImageRepository, ID: "team/app"}
+	resourceClient := client.Resource(Meta{}, corev1.Secret{})
+
+	resource, err := resourceClient.Get(context.Background(), baseURL, params)
+	g.Expect(err).To(BeNil())
+	g.Expect(resource.Name).To(Equal("team/app"))
+
+	list, err := resourceClient.ListChildren(context.Background(), baseURL, params)
+	g.Expect(err).To(BeNil())
+	g.Expect(list.Items).To(HaveLen(1))
+	g.Expect(list.Items[0].Name).To(Equal("team/app/v1"))
+}
@@ -0,0 +1,67 @@
+/*
+Copyright 2021 The Katanomi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+
+	metav1alpha1 "github.com/katanomi/pkg/apis/meta/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+// ClientUser client for users
+type ClientUser interface {
+	GetCurrent(ctx context.Context, baseURL *duckv1.Addressable, options ...OptionFunc) (*metav1alpha1.User, error)
+	List(ctx context.Context, baseURL *duckv1.Addressable, options ...OptionFunc) (*metav1alpha1.UserList, error)
+}
+
+type user struct {
+	client Client
+	meta   Meta
+	secret corev1.Secret
+}
+
+func newUser(client Client, meta Meta, secret corev1.Secret) ClientUser {
+	return &user{
+		client: client,
+		meta:   meta,
+		secret: secret,
+	}
+}
+
+// GetCurrent get the user behind the secret using plugin
+func (u *user) GetCurrent(ctx context.Context, baseURL *duckv1.Addressable, options ...OptionFunc) (*metav1alpha1.User, error) {
+	resp := &metav1alpha1.User{}
+	options = append(options, MetaOpts(u.meta), SecretOpts(u.secret), ResultOpts(resp))
+	if err := u.client.Get(ctx, baseURL, "user", options...); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// List search users using plugin, filters are set by ListOpts
+func (u *user) List(ctx context.Context, baseURL *duckv1.Addressable, options ...OptionFunc) (*metav1alpha1.UserList, error) {
+	list := &metav1alpha1.UserList{}
+	options = append(options, MetaOpts(u.meta), SecretOpts(u.secret), ResultOpts(list))
+	if err := u.client.Get(ctx, baseURL, "users", options...); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
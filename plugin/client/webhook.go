@@ -0,0 +1,87 @@
+/*
+Copyright 2021 The Katanomi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+
+	metav1alpha1 "github.com/katanomi/pkg/apis/meta/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+// ClientWebhook client for webhook registration
+type ClientWebhook interface {
+	Create(ctx context.Context, baseURL *duckv1.Addressable, spec metav1alpha1.WebhookRegisterSpec, options ...OptionFunc) (*metav1alpha1.WebhookRegisterStatus, error)
+	Update(ctx context.Context, baseURL *duckv1.Addressable, spec metav1alpha1.WebhookRegisterSpec, options ...OptionFunc) (*metav1alpha1.WebhookRegisterStatus, error)
+	Delete(ctx context.Context, baseURL *duckv1.Addressable, spec metav1alpha1.WebhookRegisterSpec, options ...OptionFunc) error
+	IsSameResource(ctx context.Context, baseURL *duckv1.Addressable, i, j metav1alpha1.ResourceURI, options ...OptionFunc) (bool, error)
+}
+
+type webhook struct {
+	client Client
+	meta   Meta
+	secret corev1.Secret
+}
+
+func newWebhook(client Client, meta Meta, secret corev1.Secret) ClientWebhook {
+	return &webhook{
+		client: client,
+		meta:   meta,
+		secret: secret,
+	}
+}
+
+// Create register a webhook using plugin
+func (w *webhook) Create(ctx context.Context, baseURL *duckv1.Addressable, spec metav1alpha1.WebhookRegisterSpec, options ...OptionFunc) (*metav1alpha1.WebhookRegisterStatus, error) {
+	status := &metav1alpha1.WebhookRegisterStatus{}
+	options = append(options, MetaOpts(w.meta), SecretOpts(w.secret), BodyOpts(spec), ResultOpts(status))
+	if err := w.client.Post(ctx, baseURL, "webhooks", options...); err != nil {
+		return nil, err
+	}
+
+	return status, nil
+}
+
+// Update update a registered webhook using plugin
+func (w *webhook) Update(ctx context.Context, baseURL *duckv1.Addressable, spec metav1alpha1.WebhookRegisterSpec, options ...OptionFunc) (*metav1alpha1.WebhookRegisterStatus, error) {
+	status := &metav1alpha1.WebhookRegisterStatus{}
+	options = append(options, MetaOpts(w.meta), SecretOpts(w.secret), BodyOpts(spec), ResultOpts(status))
+	if err := w.client.Put(ctx, baseURL, "webhooks", options...); err != nil {
+		return nil, err
+	}
+
+	return status, nil
+}
+
+// Delete delete a registered webhook using plugin
+func (w *webhook) Delete(ctx context.Context, baseURL *duckv1.Addressable, spec metav1alpha1.WebhookRegisterSpec, options ...OptionFunc) error {
+	options = append(options, MetaOpts(w.meta), SecretOpts(w.secret), BodyOpts(spec))
+	return w.client.Delete(ctx, baseURL, "webhooks", options...)
+}
+
+// IsSameResource compare two webhook resources using plugin
+func (w *webhook) IsSameResource(ctx context.Context, baseURL *duckv1.Addressable, i, j metav1alpha1.ResourceURI, options ...OptionFunc) (bool, error) {
+	result := &metav1alpha1.WebhookResourceCompareResult{}
+	payload := metav1alpha1.WebhookResourceComparePayload{Source: i, Target: j}
+	options = append(options, MetaOpts(w.meta), SecretOpts(w.secret), BodyOpts(payload), ResultOpts(result))
+	if err := w.client.Post(ctx, baseURL, "webhooks/resources/compare", options...); err != nil {
+		return false, err
+	}
+
+	return result.Same, nil
+}
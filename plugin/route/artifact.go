@@ -158,6 +158,113 @@ func (a *artifactDeleter) DeleteArtifact(request *restful.Request, response *res
 	response.WriteHeader(http.StatusOK)
 }
 
+type artifactTagList struct {
+	impl client.ArtifactTagLister
+	tags []string
+}
+
+// NewArtifactTagList create a list artifact tag route with plugin client
+func NewArtifactTagList(impl client.ArtifactTagLister) Route {
+	return &artifactTagList{
+		tags: []string{"projects", "repositories", "artifacts"},
+		impl: impl,
+	}
+}
+
+func (a *artifactTagList) Register(ws *restful.WebService) {
+	projectParam := ws.PathParameter("project", "repository belong to integraion")
+	repositoryParam := ws.PathParameter("repository", "artifact belong to repository")
+	artifactParam := ws.PathParameter("artifact", "artifact name, maybe is version or tag")
+	ws.Route(
+		ListOptionsDocs(
+			ws.GET("/projects/{project}/repositories/{repository}/tags").To(a.ListArtifactTags).
+				// docs
+				Doc("ListArtifactTags").Param(projectParam).Param(repositoryParam).
+				Metadata(restfulspec.KeyOpenAPITags, a.tags).
+				Returns(http.StatusOK, "OK", metav1alpha1.ArtifactTagList{}),
+		),
+	)
+	ws.Route(
+		ListOptionsDocs(
+			ws.GET("/projects/{project}/repositories/{repository}/artifacts/{artifact}/tags").To(a.ListArtifactTags).
+				// docs
+				// use a distinct operation as both routes share the same handler
+				Doc("ListArtifactTagsByArtifact").Operation("ListArtifactTagsByArtifact").Param(projectParam).Param(repositoryParam).Param(artifactParam).
+				Metadata(restfulspec.KeyOpenAPITags, a.tags).
+				Returns(http.StatusOK, "OK", metav1alpha1.ArtifactTagList{}),
+		),
+	)
+}
+
+// ListArtifactTags http handler for list artifact tags
+func (a *artifactTagList) ListArtifactTags(request *restful.Request, response *restful.Response) {
+	option := GetListOptionsFromRequest(request)
+	pathParams := metav1alpha1.ArtifactOptions{
+		RepositoryOptions: metav1alpha1.RepositoryOptions{
+			Project: request.PathParameter("project"),
+		},
+		Repository: request.PathParameter("repository"),
+		Artifact:   request.PathParameter("artifact"),
+	}
+	tags, err := a.impl.ListArtifactTags(request.Request.Context(), pathParams, option)
+	if err != nil {
+		kerrors.HandleError(request, response, err)
+		return
+	}
+
+	response.WriteHeaderAndEntity(http.StatusOK, tags)
+}
+
+type artifactCopier struct {
+	impl client.ArtifactCopier
+	tags []string
+}
+
+// NewArtifactCopy create a copy artifact route with plugin client
+func NewArtifactCopy(impl client.ArtifactCopier) Route {
+	return &artifactCopier{
+		tags: []string{"projects", "repositories", "artifacts"},
+		impl: impl,
+	}
+}
+
+func (a *artifactCopier) Register(ws *restful.WebService) {
+	projectParam := ws.PathParameter("project", "repository belong to integraion")
+	repositoryParam := ws.PathParameter("repository", "artifact belong to repository")
+	artifactParam := ws.PathParameter("artifact", "artifact name, maybe is version or tag")
+	ws.Route(
+		ws.POST("/projects/{project}/repositories/{repository}/artifacts/{artifact}/copy").To(a.CopyArtifact).
+			// docs
+			Doc("CopyArtifact").Param(projectParam).Param(repositoryParam).Param(artifactParam).
+			Metadata(restfulspec.KeyOpenAPITags, a.tags).
+			Reads(metav1alpha1.ArtifactCopyOptions{}, "ArtifactCopyOptions").
+			Returns(http.StatusOK, "OK", metav1alpha1.Artifact{}),
+	)
+}
+
+// CopyArtifact http handler for copy artifact
+func (a *artifactCopier) CopyArtifact(request *restful.Request, response *restful.Response) {
+	pathParams := metav1alpha1.ArtifactOptions{
+		RepositoryOptions: metav1alpha1.RepositoryOptions{
+			Project: request.PathParameter("project"),
+		},
+		Repository: request.PathParameter("repository"),
+		Artifact:   request.PathParameter("artifact"),
+	}
+	option := metav1alpha1.ArtifactCopyOptions{}
+	if err := request.ReadEntity(&option); err != nil {
+		kerrors.HandleError(request, response, err)
+		return
+	}
+	artifact, err := a.impl.CopyArtifact(request.Request.Context(), pathParams, option)
+	if err != nil {
+		kerrors.HandleError(request, response, err)
+		return
+	}
+
+	response.WriteHeaderAndEntity(http.StatusOK, artifact)
+}
+
 type scanImage struct {
 	impl client.ScanImage
 	tags []string
@@ -201,3 +308,55 @@ func (s *scanImage) ScanImage(request *restful.Request, response *restful.Respon
 
 	response.WriteHeader(http.StatusOK)
 }
+
+type scanReportGetter struct {
+	impl client.ScanReportGetter
+	tags []string
+}
+
+// NewScanReportGet create a get scan report route with plugin client
+func NewScanReportGet(impl client.ScanReportGetter) Route {
+	return &scanReportGetter{
+		tags: []string{"projects", "repositories", "artifacts"},
+		impl: impl,
+	}
+}
+
+func (s *scanReportGetter) Register(ws *restful.WebService) {
+	projectParam := ws.PathParameter("project", "repository belong to integraion")
+	repositoryParam := ws.PathParameter("repository", "artifact belong to repository")
+	artifactParam := ws.PathParameter("artifact", "artifact name, maybe is version or tag")
+	vulnerabilitiesParam := ws.QueryParameter("withVulnerabilities", "return detailed vulnerabilities").DataType("boolean").DefaultValue("false")
+	ws.Route(
+		ws.GET("/projects/{project}/repositories/{repository}/artifacts/{artifact}/scan").To(s.GetScanReport).
+			// docs
+			Doc("GetScanReport").Param(projectParam).Param(repositoryParam).Param(artifactParam).Param(vulnerabilitiesParam).
+			Metadata(restfulspec.KeyOpenAPITags, s.tags).
+			Returns(http.StatusOK, "OK", metav1alpha1.ScanReport{}),
+	)
+}
+
+// GetScanReport http handler for get scan report
+func (s *scanReportGetter) GetScanReport(request *restful.Request, response *restful.Response) {
+	pathParams := metav1alpha1.ArtifactOptions{
+		RepositoryOptions: metav1alpha1.RepositoryOptions{
+			Project: request.PathParameter("project"),
+		},
+		Repository: request.PathParameter("repository"),
+		Artifact:   request.PathParameter("artifact"),
+	}
+	option := metav1alpha1.ScanReportOptions{}
+	var err error
+	if option.WithVulnerabilities, err = parseBoolQueryParameter(request, "withVulnerabilities"); err != nil {
+		kerrors.HandleError(request, response, err)
+		return
+	}
+
+	report, err := s.impl.GetScanReport(request.Request.Context(), pathParams, option)
+	if err != nil {
+		kerrors.HandleError(request, response, err)
+		return
+	}
+
+	response.WriteHeaderAndEntity(http.StatusOK, report)
+}
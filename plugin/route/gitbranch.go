@@ -25,6 +25,7 @@ import (
 	"github.com/emicklei/go-restful/v3"
 	metav1alpha1 "github.com/katanomi/pkg/apis/meta/v1alpha1"
 	"github.com/katanomi/pkg/plugin/client"
+	"k8s.io/apimachinery/pkg/api/errors"
 )
 
 type gitBranchLister struct {
@@ -107,3 +108,128 @@ func (a *gitBranchCreator) CreateBranch(request *restful.Request, response *rest
 	}
 	response.WriteHeaderAndEntity(http.StatusOK, gitBranchObj)
 }
+
+type gitBranchDeleter struct {
+	impl client.GitBranchDeleter
+	tags []string
+}
+
+// NewGitBranchDeleter create a git branch delete route with plugin client
+func NewGitBranchDeleter(impl client.GitBranchDeleter) Route {
+	return &gitBranchDeleter{
+		tags: []string{"git", "repositories", "branch"},
+		impl: impl,
+	}
+}
+
+// Register route
+func (a *gitBranchDeleter) Register(ws *restful.WebService) {
+	repositoryParam := ws.PathParameter("repository", "branch belong to repository")
+	projectParam := ws.PathParameter("project", "repository belong to project")
+	branchParam := ws.QueryParameter("branch", "branch name")
+	ws.Route(
+		ws.DELETE("/projects/{project}/coderepositories/{repository}/branches").To(a.DeleteBranch).
+			Doc("DeleteBranch").Param(projectParam).Param(repositoryParam).Param(branchParam).
+			Metadata(restfulspec.KeyOpenAPITags, a.tags).
+			Returns(http.StatusOK, "OK", nil),
+	)
+}
+
+// DeleteBranch delete branch
+func (a *gitBranchDeleter) DeleteBranch(request *restful.Request, response *restful.Response) {
+	option, err := getBranchOptionFromRequest(request)
+	if err != nil {
+		kerrors.HandleError(request, response, err)
+		return
+	}
+	if err := a.impl.DeleteGitBranch(request.Request.Context(), option); err != nil {
+		kerrors.HandleError(request, response, err)
+		return
+	}
+	response.WriteHeader(http.StatusOK)
+}
+
+type gitBranchProtectionHandler struct {
+	impl client.GitBranchProtectionHandler
+	tags []string
+}
+
+// NewGitBranchProtectionHandler create git branch protection routes with plugin client
+func NewGitBranchProtectionHandler(impl client.GitBranchProtectionHandler) Route {
+	return &gitBranchProtectionHandler{
+		tags: []string{"git", "repositories", "branch", "protection"},
+		impl: impl,
+	}
+}
+
+// Register route
+func (a *gitBranchProtectionHandler) Register(ws *restful.WebService) {
+	repositoryParam := ws.PathParameter("repository", "branch belong to repository")
+	projectParam := ws.PathParameter("project", "repository belong to project")
+	branchParam := ws.QueryParameter("branch", "branch name")
+	ws.Route(
+		ws.PUT("/projects/{project}/coderepositories/{repository}/branches/protection").To(a.ProtectBranch).
+			Doc("ProtectBranch").Param(projectParam).Param(repositoryParam).Param(branchParam).
+			Metadata(restfulspec.KeyOpenAPITags, a.tags).
+			Reads(metav1alpha1.BranchProtectionParams{}).
+			Returns(http.StatusOK, "OK", metav1alpha1.GitBranch{}),
+	)
+	ws.Route(
+		ws.DELETE("/projects/{project}/coderepositories/{repository}/branches/protection").To(a.UnprotectBranch).
+			Doc("UnprotectBranch").Param(projectParam).Param(repositoryParam).Param(branchParam).
+			Metadata(restfulspec.KeyOpenAPITags, a.tags).
+			Returns(http.StatusOK, "OK", metav1alpha1.GitBranch{}),
+	)
+}
+
+// ProtectBranch set protection rules for branch
+func (a *gitBranchProtectionHandler) ProtectBranch(request *restful.Request, response *restful.Response) {
+	option, err := getBranchOptionFromRequest(request)
+	if err != nil {
+		kerrors.HandleError(request, response, err)
+		return
+	}
+	var params metav1alpha1.BranchProtectionParams
+	if err := request.ReadEntity(&params); err != nil {
+		kerrors.HandleError(request, response, err)
+		return
+	}
+	payload := metav1alpha1.BranchProtectionPayload{GitBranchOption: option, BranchProtectionParams: params}
+	gitBranchObj, err := a.impl.ProtectGitBranch(request.Request.Context(), payload)
+	if err != nil {
+		kerrors.HandleError(request, response, err)
+		return
+	}
+	response.WriteHeaderAndEntity(http.StatusOK, gitBranchObj)
+}
+
+// UnprotectBranch remove protection rules from branch
+func (a *gitBranchProtectionHandler) UnprotectBranch(request *restful.Request, response *restful.Response) {
+	option, err := getBranchOptionFromRequest(request)
+	if err != nil {
+		kerrors.HandleError(request, response, err)
+		return
+	}
+	gitBranchObj, err := a.impl.UnprotectGitBranch(request.Request.Context(), option)
+	if err != nil {
+		kerrors.HandleError(request, response, err)
+		return
+	}
+	response.WriteHeaderAndEntity(http.StatusOK, gitBranchObj)
+}
+
+// getBranchOptionFromRequest returns GitBranchOption based on path and query parameters
+// the branch is read from the query as branch names may contain "/"
+func getBranchOptionFromRequest(request *restful.Request) (metav1alpha1.GitBranchOption, error) {
+	option := metav1alpha1.GitBranchOption{
+		GitRepo: metav1alpha1.GitRepo{
+			Repository: request.PathParameter("repository"),
+			Project:    request.PathParameter("project"),
+		},
+		Branch: request.QueryParameter("branch"),
+	}
+	if option.Branch == "" {
+		return option, errors.NewBadRequest("branch query parameter is required")
+	}
+	return option, nil
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2021 The Katanomi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package route
+
+import (
+	"net/http"
+
+	restfulspec "github.com/emicklei/go-restful-openapi/v2"
+	"github.com/emicklei/go-restful/v3"
+	metav1alpha1 "github.com/katanomi/pkg/apis/meta/v1alpha1"
+	kerrors "github.com/katanomi/pkg/errors"
+	"github.com/katanomi/pkg/plugin/client"
+	"k8s.io/apimachinery/pkg/api/errors"
+)
+
+type gitCodeSearcher struct {
+	impl client.GitCodeSearcher
+	tags []string
+}
+
+// NewGitCodeSearcher create a code search route with plugin client
+func NewGitCodeSearcher(impl client.GitCodeSearcher) Route {
+	return &gitCodeSearcher{
+		tags: []string{"git", "code"},
+		impl: impl,
+	}
+}
+
+// Register route
+func (g *gitCodeSearcher) Register(ws *restful.WebService) {
+	ws.Route(
+		ListOptionsDocs(
+			ws.GET("/code/search").To(g.SearchGitCode).
+				Doc("SearchGitCode").
+				Param(ws.QueryParameter("query", "text to search").Required(true)).
+				Param(ws.QueryParameter("project", "limit search to project")).
+				Param(ws.QueryParameter("repository", "limit search to repository")).
+				Param(ws.QueryParameter("path", "limit search to files under path")).
+				Param(ws.QueryParameter("language", "limit search to files of language")).
+				Metadata(restfulspec.KeyOpenAPITags, g.tags).
+				Returns(http.StatusOK, "OK", metav1alpha1.GitCodeSearchResultList{}),
+		),
+	)
+}
+
+// SearchGitCode search code across repositories
+func (g *gitCodeSearcher) SearchGitCode(request *restful.Request, response *restful.Response) {
+	listOption := GetListOptionsFromRequest(request)
+	option := metav1alpha1.GitCodeSearchOption{
+		GitRepo: metav1alpha1.GitRepo{
+			Project:    request.QueryParameter("project"),
+			Repository: request.QueryParameter("repository"),
+		},
+		Query:    request.QueryParameter("query"),
+		Path:     request.QueryParameter("path"),
+		Language: request.QueryParameter("language"),
+	}
+	if option.Query == "" {
+		kerrors.HandleError(request, response, errors.NewBadRequest("query is required"))
+		return
+	}
+	for _, key := range []string{"query", "project", "repository", "path", "language"} {
+		delete(listOption.Search, key)
+	}
+
+	results, err := g.impl.SearchGitCode(request.Request.Context(), option, listOption)
+	if err != nil {
+		kerrors.HandleError(request, response, err)
+		return
+	}
+	response.WriteHeaderAndEntity(http.StatusOK, results)
+}
@@ -17,9 +17,14 @@ limitations under the License.
 package route
 
 import (
+	"fmt"
 	"net/http"
+	"strconv"
+	"time"
 
 	kerrors "github.com/katanomi/pkg/errors"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	restfulspec "github.com/emicklei/go-restful-openapi/v2"
 	"github.com/emicklei/go-restful/v3"
@@ -69,3 +74,90 @@ func (a *gitCommitGetter) GetCommit(request *restful.Request, response *restful.
 	}
 	response.WriteHeaderAndEntity(http.StatusOK, commitObject)
 }
+
+type gitCommitLister struct {
+	impl client.GitCommitLister
+	tags []string
+}
+
+// NewGitCommitLister list git commits route with plugin client
+func NewGitCommitLister(impl client.GitCommitLister) Route {
+	return &gitCommitLister{
+		tags: []string{"git", "repositories", "commit"},
+		impl: impl,
+	}
+}
+
+// Register route
+func (a *gitCommitLister) Register(ws *restful.WebService) {
+	repositoryParam := ws.PathParameter("repository", "commit belong to repository")
+	projectParam := ws.PathParameter("project", "repository belong to project")
+	refParam := ws.QueryParameter("ref", "commits belong to commit/branch/tag name")
+	pathParam := ws.QueryParameter("path", "only commits containing this file path")
+	authorParam := ws.QueryParameter("author", "only commits of this author")
+	sinceParam := ws.QueryParameter("since", "only commits after or on this date, RFC3339 format")
+	untilParam := ws.QueryParameter("until", "only commits before or on this date, RFC3339 format")
+	ws.Route(
+		ListOptionsDocs(
+			ws.GET("/projects/{project}/coderepositories/{repository}/commits").To(a.ListCommit).
+				Doc("ListGitCommit").Param(projectParam).Param(repositoryParam).
+				Param(refParam).Param(pathParam).Param(authorParam).Param(sinceParam).Param(untilParam).
+				Metadata(restfulspec.KeyOpenAPITags, a.tags).
+				Returns(http.StatusOK, "OK", metav1alpha1.GitCommitList{}),
+		),
+	)
+}
+
+// ListCommit list commits by repo and filters
+func (a *gitCommitLister) ListCommit(request *restful.Request, response *restful.Response) {
+	listOption := GetListOptionsFromRequest(request)
+	repo := request.PathParameter("repository")
+	project := request.PathParameter("project")
+	option := metav1alpha1.GitCommitListOption{
+		GitRepo: metav1alpha1.GitRepo{Repository: repo, Project: project},
+		Ref:     request.QueryParameter("ref"),
+		Path:    request.QueryParameter("path"),
+		Author:  request.QueryParameter("author"),
+	}
+	var err error
+	if option.Since, err = parseTimeQueryParameter(request, "since"); err != nil {
+		kerrors.HandleError(request, response, err)
+		return
+	}
+	if option.Until, err = parseTimeQueryParameter(request, "until"); err != nil {
+		kerrors.HandleError(request, response, err)
+		return
+	}
+	commitList, err := a.impl.ListGitCommit(request.Request.Context(), option, listOption)
+	if err != nil {
+		kerrors.HandleError(request, response, err)
+		return
+	}
+	response.WriteHeaderAndEntity(http.StatusOK, commitList)
+}
+
+// parseTimeQueryParameter parse a RFC3339 time from query parameter, returns nil when empty
+func parseTimeQueryParameter(request *restful.Request, name string) (*metav1.Time, error) {
+	value := request.QueryParameter(name)
+	if value == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, errors.NewBadRequest(fmt.Sprintf("invalid %s query parameter: %s", name, err.Error()))
+	}
+	return &metav1.Time{Time: t}, nil
+}
+
+// parseBoolQueryParameter parse a boolean from query parameter, returns false when empty
+func parseBoolQueryParameter(request *restful.Request, name string) (bool, error) {
+	value := request.QueryParameter(name)
+	if value == "" {
+		return false, nil
+	}
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, errors.NewBadRequest(fmt.Sprintf("invalid %s query parameter: %s", name, err.Error()))
+	}
+	return b, nil
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2021 The Katanomi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package route
+
+import (
+	"fmt"
+	"net/http"
+
+	kerrors "github.com/katanomi/pkg/errors"
+
+	restfulspec "github.com/emicklei/go-restful-openapi/v2"
+	"github.com/emicklei/go-restful/v3"
+	metav1alpha1 "github.com/katanomi/pkg/apis/meta/v1alpha1"
+	"github.com/katanomi/pkg/plugin/client"
+	"k8s.io/apimachinery/pkg/api/errors"
+)
+
+type gitCommitStatusLister struct {
+	impl client.GitCommitStatusLister
+	tags []string
+}
+
+// NewGitCommitStatusLister list git commit statuses route with plugin client
+func NewGitCommitStatusLister(impl client.GitCommitStatusLister) Route {
+	return &gitCommitStatusLister{
+		tags: []string{"git", "repositories", "commit", "status"},
+		impl: impl,
+	}
+}
+
+// Register route
+func (a *gitCommitStatusLister) Register(ws *restful.WebService) {
+	repositoryParam := ws.PathParameter("repository", "commit belong to repository")
+	shaParam := ws.PathParameter("sha", "commit sha")
+	projectParam := ws.PathParameter("project", "repository belong to project")
+	ws.Route(
+		ListOptionsDocs(
+			ws.GET("/projects/{project}/coderepositories/{repository}/commit/{sha}/statuses").To(a.ListCommitStatus).
+				Doc("ListGitCommitStatus").Param(projectParam).Param(repositoryParam).Param(shaParam).
+				Metadata(restfulspec.KeyOpenAPITags, a.tags).
+				Returns(http.StatusOK, "OK", metav1alpha1.GitCommitStatusList{}),
+		),
+	)
+}
+
+// ListCommitStatus list statuses of a commit
+func (a *gitCommitStatusLister) ListCommitStatus(request *restful.Request, response *restful.Response) {
+	listOption := GetListOptionsFromRequest(request)
+	sha := request.PathParameter("sha")
+	repo := request.PathParameter("repository")
+	project := request.PathParameter("project")
+	commitOption := metav1alpha1.GitCommitOption{
+		GitRepo:            metav1alpha1.GitRepo{Repository: repo, Project: project},
+		GitCommitBasicInfo: metav1alpha1.GitCommitBasicInfo{SHA: &sha},
+	}
+	statusList, err := a.impl.ListGitCommitStatus(request.Request.Context(), commitOption, listOption)
+	if err != nil {
+		kerrors.HandleError(request, response, err)
+		return
+	}
+	response.WriteHeaderAndEntity(http.StatusOK, statusList)
+}
+
+type gitCommitStatusCreator struct {
+	impl client.GitCommitStatusCreator
+	tags []string
+}
+
+// NewGitCommitStatusCreator create git commit status route with plugin client
+func NewGitCommitStatusCreator(impl client.GitCommitStatusCreator) Route {
+	return &gitCommitStatusCreator{
+		tags: []string{"git", "repositories", "commit", "status"},
+		impl: impl,
+	}
+}
+
+// Register route
+func (a *gitCommitStatusCreator) Register(ws *restful.WebService) {
+	repositoryParam := ws.PathParameter("repository", "commit belong to repository")
+	shaParam := ws.PathParameter("sha", "commit sha")
+	projectParam := ws.PathParameter("project", "repository belong to project")
+	ws.Route(
+		ws.POST("/projects/{project}/coderepositories/{repository}/commit/{sha}/statuses").To(a.CreateCommitStatus).
+			Doc("CreateGitCommitStatus").Param(projectParam).Param(repositoryParam).Param(shaParam).
+			Metadata(restfulspec.KeyOpenAPITags, a.tags).
+			Reads(metav1alpha1.CreateCommitStatusParams{}).
+			Returns(http.StatusOK, "OK", metav1alpha1.GitCommitStatus{}).
+			Returns(http.StatusBadRequest, "Invalid state", nil),
+	)
+}
+
+// CreateCommitStatus report a status for a commit
+func (a *gitCommitStatusCreator) CreateCommitStatus(request *restful.Request, response *restful.Response) {
+	sha := request.PathParameter("sha")
+	repo := request.PathParameter("repository")
+	project := request.PathParameter("project")
+	var params metav1alpha1.CreateCommitStatusParams
+	if err := request.ReadEntity(&params); err != nil {
+		kerrors.HandleError(request, response, err)
+		return
+	}
+	if !params.State.IsValid() {
+		kerrors.HandleError(request, response, errors.NewBadRequest(fmt.Sprintf("invalid commit status state %q", params.State)))
+		return
+	}
+	payload := metav1alpha1.CreateCommitStatusPayload{
+		GitCommitOption: metav1alpha1.GitCommitOption{
+			GitRepo:            metav1alpha1.GitRepo{Repository: repo, Project: project},
+			GitCommitBasicInfo: metav1alpha1.GitCommitBasicInfo{SHA: &sha},
+		},
+		CreateCommitStatusParams: params,
+	}
+	status, err := a.impl.CreateGitCommitStatus(request.Request.Context(), payload)
+	if err != nil {
+		kerrors.HandleError(request, response, err)
+		return
+	}
+	response.WriteHeaderAndEntity(http.StatusOK, status)
+}
@@ -0,0 +1,78 @@
+/*
+Copyright 2021 The Katanomi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package route
+
+import (
+	"net/http"
+
+	kerrors "github.com/katanomi/pkg/errors"
+
+	restfulspec "github.com/emicklei/go-restful-openapi/v2"
+	"github.com/emicklei/go-restful/v3"
+	metav1alpha1 "github.com/katanomi/pkg/apis/meta/v1alpha1"
+	"github.com/katanomi/pkg/plugin/client"
+	"k8s.io/apimachinery/pkg/api/errors"
+)
+
+type gitCompareGetter struct {
+	impl client.GitCompareGetter
+	tags []string
+}
+
+// NewGitCompareGetter create a git compare route with plugin client
+func NewGitCompareGetter(impl client.GitCompareGetter) Route {
+	return &gitCompareGetter{
+		tags: []string{"git", "repositories", "compare"},
+		impl: impl,
+	}
+}
+
+// Register route
+func (a *gitCompareGetter) Register(ws *restful.WebService) {
+	repositoryParam := ws.PathParameter("repository", "refs belong to repository")
+	projectParam := ws.PathParameter("project", "repository belong to project")
+	baseParam := ws.QueryParameter("base", "commit/branch/tag name used as comparison base").Required(true)
+	headParam := ws.QueryParameter("head", "commit/branch/tag name compared against base").Required(true)
+	ws.Route(
+		ws.GET("/projects/{project}/coderepositories/{repository}/compare").To(a.GetGitCompare).
+			Doc("GetGitCompare").Param(projectParam).Param(repositoryParam).Param(baseParam).Param(headParam).
+			Metadata(restfulspec.KeyOpenAPITags, a.tags).
+			Returns(http.StatusOK, "OK", metav1alpha1.GitCompare{}).
+			Returns(http.StatusBadRequest, "Base or head is empty", nil),
+	)
+}
+
+// GetGitCompare compare two refs
+func (a *gitCompareGetter) GetGitCompare(request *restful.Request, response *restful.Response) {
+	repo := request.PathParameter("repository")
+	project := request.PathParameter("project")
+	option := metav1alpha1.GitCompareOption{
+		GitRepo: metav1alpha1.GitRepo{Repository: repo, Project: project},
+		Base:    request.QueryParameter("base"),
+		Head:    request.QueryParameter("head"),
+	}
+	if option.Base == "" || option.Head == "" {
+		kerrors.HandleError(request, response, errors.NewBadRequest("base and head are required"))
+		return
+	}
+	compare, err := a.impl.GetGitCompare(request.Request.Context(), option)
+	if err != nil {
+		kerrors.HandleError(request, response, err)
+		return
+	}
+	response.WriteHeaderAndEntity(http.StatusOK, compare)
+}
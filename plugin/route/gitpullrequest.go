@@ -173,3 +173,528 @@ func (a *gitPullRequestNoteCreator) CreateGitPullRequestNote(request *restful.Re
 	}
 	response.WriteHeaderAndEntity(http.StatusOK, note)
 }
+
+// getPullRequestOptionFromRequest returns GitPullRequestOption based on path parameters
+func getPullRequestOptionFromRequest(request *restful.Request) (option metav1alpha1.GitPullRequestOption, err error) {
+	option.Index, err = strconv.Atoi(request.PathParameter("index"))
+	if err != nil {
+		return
+	}
+	option.GitRepo = metav1alpha1.GitRepo{
+		Repository: request.PathParameter("repository"),
+		Project:    request.PathParameter("project"),
+	}
+	return
+}
+
+type gitPullRequestMerger struct {
+	impl client.GitPullRequestMerger
+	tags []string
+}
+
+// NewGitPullRequestMerger create a git pr merge route with plugin client
+func NewGitPullRequestMerger(impl client.GitPullRequestMerger) Route {
+	return &gitPullRequestMerger{
+		tags: []string{"git", "repositories", "pull request"},
+		impl: impl,
+	}
+}
+
+// Register route
+func (a *gitPullRequestMerger) Register(ws *restful.WebService) {
+	repositoryParam := ws.PathParameter("repository", "pulls belong to repository")
+	projectParam := ws.PathParameter("project", "repository belong to project")
+	indexParam := ws.PathParameter("index", "pr index")
+	ws.Route(
+		ws.PUT("/projects/{project}/coderepositories/{repository}/pulls/{index}/merge").To(a.MergeGitPullRequest).
+			Doc("MergePullRequest").Param(projectParam).Param(repositoryParam).Param(indexParam).
+			Metadata(restfulspec.KeyOpenAPITags, a.tags).
+			Reads(metav1alpha1.MergePullRequestParams{}).
+			Returns(http.StatusOK, "OK", metav1alpha1.GitPullRequest{}),
+	)
+}
+
+// MergeGitPullRequest merge a pr
+func (a *gitPullRequestMerger) MergeGitPullRequest(request *restful.Request, response *restful.Response) {
+	option, err := getPullRequestOptionFromRequest(request)
+	if err != nil {
+		kerrors.HandleError(request, response, err)
+		return
+	}
+	var params metav1alpha1.MergePullRequestParams
+	if err = request.ReadEntity(&params); err != nil {
+		kerrors.HandleError(request, response, err)
+		return
+	}
+	prObject, err := a.impl.MergePullRequest(request.Request.Context(), metav1alpha1.MergePullRequestPayload{
+		GitRepo:                option.GitRepo,
+		Index:                  option.Index,
+		MergePullRequestParams: params,
+	})
+	if err != nil {
+		kerrors.HandleError(request, response, err)
+		return
+	}
+	response.WriteHeaderAndEntity(http.StatusOK, prObject)
+}
+
+type gitPullRequestCloser struct {
+	impl client.GitPullRequestCloser
+	tags []string
+}
+
+// NewGitPullRequestCloser create a git pr close route with plugin client
+func NewGitPullRequestCloser(impl client.GitPullRequestCloser) Route {
+	return &gitPullRequestCloser{
+		tags: []string{"git", "repositories", "pull request"},
+		impl: impl,
+	}
+}
+
+// Register route
+func (a *gitPullRequestCloser) Register(ws *restful.WebService) {
+	repositoryParam := ws.PathParameter("repository", "pulls belong to repository")
+	projectParam := ws.PathParameter("project", "repository belong to project")
+	indexParam := ws.PathParameter("index", "pr index")
+	ws.Route(
+		ws.PUT("/projects/{project}/coderepositories/{repository}/pulls/{index}/close").To(a.CloseGitPullRequest).
+			Doc("ClosePullRequest").Param(projectParam).Param(repositoryParam).Param(indexParam).
+			Metadata(restfulspec.KeyOpenAPITags, a.tags).
+			Returns(http.StatusOK, "OK", metav1alpha1.GitPullRequest{}),
+	)
+}
+
+// CloseGitPullRequest close a pr
+func (a *gitPullRequestCloser) CloseGitPullRequest(request *restful.Request, response *restful.Response) {
+	option, err := getPullRequestOptionFromRequest(request)
+	if err != nil {
+		kerrors.HandleError(request, response, err)
+		return
+	}
+	prObject, err := a.impl.ClosePullRequest(request.Request.Context(), option)
+	if err != nil {
+		kerrors.HandleError(request, response, err)
+		return
+	}
+	response.WriteHeaderAndEntity(http.StatusOK, prObject)
+}
+
+type gitPullRequestReopener struct {
+	impl client.GitPullRequestReopener
+	tags []string
+}
+
+// NewGitPullRequestReopener create a git pr reopen route with plugin client
+func NewGitPullRequestReopener(impl client.GitPullRequestReopener) Route {
+	return &gitPullRequestReopener{
+		tags: []string{"git", "repositories", "pull request"},
+		impl: impl,
+	}
+}
+
+// Register route
+func (a *gitPullRequestReopener) Register(ws *restful.WebService) {
+	repositoryParam := ws.PathParameter("repository", "pulls belong to repository")
+	projectParam := ws.PathParameter("project", "repository belong to project")
+	indexParam := ws.PathParameter("index", "pr index")
+	ws.Route(
+		ws.PUT("/projects/{project}/coderepositories/{repository}/pulls/{index}/reopen").To(a.ReopenGitPullRequest).
+			Doc("ReopenPullRequest").Param(projectParam).Param(repositoryParam).Param(indexParam).
+			Metadata(restfulspec.KeyOpenAPITags, a.tags).
+			Returns(http.StatusOK, "OK", metav1alpha1.GitPullRequest{}),
+	)
+}
+
+// ReopenGitPullRequest reopen a closed pr
+func (a *gitPullRequestReopener) ReopenGitPullRequest(request *restful.Request, response *restful.Response) {
+	option, err := getPullRequestOptionFromRequest(request)
+	if err != nil {
+		kerrors.HandleError(request, response, err)
+		return
+	}
+	prObject, err := a.impl.ReopenPullRequest(request.Request.Context(), option)
+	if err != nil {
+		kerrors.HandleError(request, response, err)
+		return
+	}
+	response.WriteHeaderAndEntity(http.StatusOK, prObject)
+}
+
+type gitPullRequestUpdater struct {
+	impl client.GitPullRequestUpdater
+	tags []string
+}
+
+// NewGitPullRequestUpdater create a git pr update route with plugin client
+func NewGitPullRequestUpdater(impl client.GitPullRequestUpdater) Route {
+	return &gitPullRequestUpdater{
+		tags: []string{"git", "repositories", "pull request"},
+		impl: impl,
+	}
+}
+
+// Register route
+func (a *gitPullRequestUpdater) Register(ws *restful.WebService) {
+	repositoryParam := ws.PathParameter("repository", "pulls belong to repository")
+	projectParam := ws.PathParameter("project", "repository belong to project")
+	indexParam := ws.PathParameter("index", "pr index")
+	ws.Route(
+		ws.PUT("/projects/{project}/coderepositories/{repository}/pulls/{index}").To(a.UpdateGitPullRequest).
+			Doc("UpdatePullRequest").Param(projectParam).Param(repositoryParam).Param(indexParam).
+			Metadata(restfulspec.KeyOpenAPITags, a.tags).
+			Reads(metav1alpha1.UpdatePullRequestParams{}).
+			Returns(http.StatusOK, "OK", metav1alpha1.GitPullRequest{}),
+	)
+}
+
+// UpdateGitPullRequest update pr title, description or target branch
+func (a *gitPullRequestUpdater) UpdateGitPullRequest(request *restful.Request, response *restful.Response) {
+	option, err := getPullRequestOptionFromRequest(request)
+	if err != nil {
+		kerrors.HandleError(request, response, err)
+		return
+	}
+	var params metav1alpha1.UpdatePullRequestParams
+	if err = request.ReadEntity(&params); err != nil {
+		kerrors.HandleError(request, response, err)
+		return
+	}
+	prObject, err := a.impl.UpdatePullRequest(request.Request.Context(), metav1alpha1.UpdatePullRequestPayload{
+		GitRepo:                 option.GitRepo,
+		Index:                   option.Index,
+		UpdatePullRequestParams: params,
+	})
+	if err != nil {
+		kerrors.HandleError(request, response, err)
+		return
+	}
+	response.WriteHeaderAndEntity(http.StatusOK, prObject)
+}
+
+type gitPullRequestCommitLister struct {
+	impl client.GitPullRequestCommitLister
+	tags []string
+}
+
+// NewGitPullRequestCommitLister create a git pr commit list route with plugin client
+func NewGitPullRequestCommitLister(impl client.GitPullRequestCommitLister) Route {
+	return &gitPullRequestCommitLister{
+		tags: []string{"git", "repositories", "pull request", "commit"},
+		impl: impl,
+	}
+}
+
+// Register route
+func (a *gitPullRequestCommitLister) Register(ws *restful.WebService) {
+	repositoryParam := ws.PathParameter("repository", "pulls belong to repository")
+	projectParam := ws.PathParameter("project", "repository belong to project")
+	indexParam := ws.PathParameter("index", "pr index")
+	ws.Route(
+		ListOptionsDocs(
+			ws.GET("/projects/{project}/coderepositories/{repository}/pulls/{index}/commits").To(a.ListGitPullRequestCommits).
+				Doc("ListPullRequestCommits").Param(projectParam).Param(repositoryParam).Param(indexParam).
+				Metadata(restfulspec.KeyOpenAPITags, a.tags).
+				Returns(http.StatusOK, "OK", metav1alpha1.GitCommitList{}),
+		),
+	)
+}
+
+// ListGitPullRequestCommits list commits of a pr
+func (a *gitPullRequestCommitLister) ListGitPullRequestCommits(request *restful.Request, response *restful.Response) {
+	option, err := getPullRequestOptionFromRequest(request)
+	if err != nil {
+		kerrors.HandleError(request, response, err)
+		return
+	}
+	listOption := GetListOptionsFromRequest(request)
+	commitList, err := a.impl.ListPullRequestCommits(request.Request.Context(), option, listOption)
+	if err != nil {
+		kerrors.HandleError(request, response, err)
+		return
+	}
+	response.WriteHeaderAndEntity(http.StatusOK, commitList)
+}
+
+type gitPullRequestFileLister struct {
+	impl client.GitPullRequestFileLister
+	tags []string
+}
+
+// NewGitPullRequestFileLister create a git pr changed file list route with plugin client
+func NewGitPullRequestFileLister(impl client.GitPullRequestFileLister) Route {
+	return &gitPullRequestFileLister{
+		tags: []string{"git", "repositories", "pull request", "file"},
+		impl: impl,
+	}
+}
+
+// Register route
+func (a *gitPullRequestFileLister) Register(ws *restful.WebService) {
+	repositoryParam := ws.PathParameter("repository", "pulls belong to repository")
+	projectParam := ws.PathParameter("project", "repository belong to project")
+	indexParam := ws.PathParameter("index", "pr index")
+	ws.Route(
+		ListOptionsDocs(
+			ws.GET("/projects/{project}/coderepositories/{repository}/pulls/{index}/files").To(a.ListGitPullRequestFiles).
+				Doc("ListPullRequestFiles").Param(projectParam).Param(repositoryParam).Param(indexParam).
+				Metadata(restfulspec.KeyOpenAPITags, a.tags).
+				Returns(http.StatusOK, "OK", metav1alpha1.GitChangedFileList{}),
+		),
+	)
+}
+
+// ListGitPullRequestFiles list changed files of a pr
+func (a *gitPullRequestFileLister) ListGitPullRequestFiles(request *restful.Request, response *restful.Response) {
+	option, err := getPullRequestOptionFromRequest(request)
+	if err != nil {
+		kerrors.HandleError(request, response, err)
+		return
+	}
+	listOption := GetListOptionsFromRequest(request)
+	fileList, err := a.impl.ListPullRequestFiles(request.Request.Context(), option, listOption)
+	if err != nil {
+		kerrors.HandleError(request, response, err)
+		return
+	}
+	response.WriteHeaderAndEntity(http.StatusOK, fileList)
+}
+
+type gitPullRequestNoteLister struct {
+	impl client.GitPullRequestCommentLister
+	tags []string
+}
+
+// NewGitPullRequestNoteLister create a git pr note list route with plugin client
+func NewGitPullRequestNoteLister(impl client.GitPullRequestCommentLister) Route {
+	return &gitPullRequestNoteLister{
+		tags: []string{"git", "repositories", "pull request", "note"},
+		impl: impl,
+	}
+}
+
+// Register route
+func (a *gitPullRequestNoteLister) Register(ws *restful.WebService) {
+	repositoryParam := ws.PathParameter("repository", "pulls belong to repository")
+	projectParam := ws.PathParameter("project", "repository belong to project")
+	indexParam := ws.PathParameter("index", "note belong to index")
+	ws.Route(
+		ListOptionsDocs(
+			ws.GET("/projects/{project}/coderepositories/{repository}/pulls/{index}/note").To(a.ListGitPullRequestNote).
+				Doc("ListPullRequestComment").Param(projectParam).Param(repositoryParam).Param(indexParam).
+				Metadata(restfulspec.KeyOpenAPITags, a.tags).
+				Returns(http.StatusOK, "OK", metav1alpha1.GitPullRequestNoteList{}),
+		),
+	)
+}
+
+// ListGitPullRequestNote list pr notes
+func (a *gitPullRequestNoteLister) ListGitPullRequestNote(request *restful.Request, response *restful.Response) {
+	option, err := getPullRequestOptionFromRequest(request)
+	if err != nil {
+		kerrors.HandleError(request, response, err)
+		return
+	}
+	listOption := GetListOptionsFromRequest(request)
+	noteList, err := a.impl.ListPullRequestComment(request.Request.Context(), option, listOption)
+	if err != nil {
+		kerrors.HandleError(request, response, err)
+		return
+	}
+	response.WriteHeaderAndEntity(http.StatusOK, noteList)
+}
+
+// getPullRequestCommentOptionFromRequest returns GitPullRequestCommentOption based on path parameters
+func getPullRequestCommentOptionFromRequest(request *restful.Request) (option metav1alpha1.GitPullRequestCommentOption, err error) {
+	option.GitPullRequestOption, err = getPullRequestOptionFromRequest(request)
+	if err != nil {
+		return
+	}
+	option.CommentID, err = strconv.Atoi(request.PathParameter("commentID"))
+	return
+}
+
+type gitPullRequestNoteUpdater struct {
+	impl client.GitPullRequestCommentUpdater
+	tags []string
+}
+
+// NewGitPullRequestNoteUpdater create a git pr note update route with plugin client
+func NewGitPullRequestNoteUpdater(impl client.GitPullRequestCommentUpdater) Route {
+	return &gitPullRequestNoteUpdater{
+		tags: []string{"git", "repositories", "pull request", "note"},
+		impl: impl,
+	}
+}
+
+// Register route
+func (a *gitPullRequestNoteUpdater) Register(ws *restful.WebService) {
+	repositoryParam := ws.PathParameter("repository", "pulls belong to repository")
+	projectParam := ws.PathParameter("project", "repository belong to project")
+	indexParam := ws.PathParameter("index", "note belong to index")
+	commentParam := ws.PathParameter("commentID", "note id")
+	ws.Route(
+		ws.PUT("/projects/{project}/coderepositories/{repository}/pulls/{index}/note/{commentID}").To(a.UpdateGitPullRequestNote).
+			Doc("UpdatePullRequestComment").Param(projectParam).Param(repositoryParam).Param(indexParam).Param(commentParam).
+			Metadata(restfulspec.KeyOpenAPITags, a.tags).
+			Reads(metav1alpha1.UpdatePullRequestCommentParam{}).
+			Returns(http.StatusOK, "OK", metav1alpha1.GitPullRequestNote{}),
+	)
+}
+
+// UpdateGitPullRequestNote update pr note
+func (a *gitPullRequestNoteUpdater) UpdateGitPullRequestNote(request *restful.Request, response *restful.Response) {
+	option, err := getPullRequestCommentOptionFromRequest(request)
+	if err != nil {
+		kerrors.HandleError(request, response, err)
+		return
+	}
+	var params metav1alpha1.UpdatePullRequestCommentParam
+	if err = request.ReadEntity(&params); err != nil {
+		kerrors.HandleError(request, response, err)
+		return
+	}
+	note, err := a.impl.UpdatePullRequestComment(request.Request.Context(), metav1alpha1.UpdatePullRequestCommentPayload{
+		GitPullRequestCommentOption:   option,
+		UpdatePullRequestCommentParam: params,
+	})
+	if err != nil {
+		kerrors.HandleError(request, response, err)
+		return
+	}
+	response.WriteHeaderAndEntity(http.StatusOK, note)
+}
+
+type gitPullRequestNoteDeleter struct {
+	impl client.GitPullRequestCommentDeleter
+	tags []string
+}
+
+// NewGitPullRequestNoteDeleter create a git pr note delete route with plugin client
+func NewGitPullRequestNoteDeleter(impl client.GitPullRequestCommentDeleter) Route {
+	return &gitPullRequestNoteDeleter{
+		tags: []string{"git", "repositories", "pull request", "note"},
+		impl: impl,
+	}
+}
+
+// Register route
+func (a *gitPullRequestNoteDeleter) Register(ws *restful.WebService) {
+	repositoryParam := ws.PathParameter("repository", "pulls belong to repository")
+	projectParam := ws.PathParameter("project", "repository belong to project")
+	indexParam := ws.PathParameter("index", "note belong to index")
+	commentParam := ws.PathParameter("commentID", "note id")
+	ws.Route(
+		ws.DELETE("/projects/{project}/coderepositories/{repository}/pulls/{index}/note/{commentID}").To(a.DeleteGitPullRequestNote).
+			Doc("DeletePullRequestComment").Param(projectParam).Param(repositoryParam).Param(indexParam).Param(commentParam).
+			Metadata(restfulspec.KeyOpenAPITags, a.tags).
+			Returns(http.StatusOK, "OK", nil),
+	)
+}
+
+// DeleteGitPullRequestNote delete pr note
+func (a *gitPullRequestNoteDeleter) DeleteGitPullRequestNote(request *restful.Request, response *restful.Response) {
+	option, err := getPullRequestCommentOptionFromRequest(request)
+	if err != nil {
+		kerrors.HandleError(request, response, err)
+		return
+	}
+	if err = a.impl.DeletePullRequestComment(request.Request.Context(), option); err != nil {
+		kerrors.HandleError(request, response, err)
+		return
+	}
+	response.WriteHeader(http.StatusOK)
+}
+
+type gitPullRequestReviewLister struct {
+	impl client.GitPullRequestReviewLister
+	tags []string
+}
+
+// NewGitPullRequestReviewLister create a git pr review list route with plugin client
+func NewGitPullRequestReviewLister(impl client.GitPullRequestReviewLister) Route {
+	return &gitPullRequestReviewLister{
+		tags: []string{"git", "repositories", "pull request", "review"},
+		impl: impl,
+	}
+}
+
+// Register route
+func (a *gitPullRequestReviewLister) Register(ws *restful.WebService) {
+	repositoryParam := ws.PathParameter("repository", "pulls belong to repository")
+	projectParam := ws.PathParameter("project", "repository belong to project")
+	indexParam := ws.PathParameter("index", "review belong to index")
+	ws.Route(
+		ListOptionsDocs(
+			ws.GET("/projects/{project}/coderepositories/{repository}/pulls/{index}/reviews").To(a.ListGitPullRequestReview).
+				Doc("ListPullRequestReview").Param(projectParam).Param(repositoryParam).Param(indexParam).
+				Metadata(restfulspec.KeyOpenAPITags, a.tags).
+				Returns(http.StatusOK, "OK", metav1alpha1.GitPullRequestReviewList{}),
+		),
+	)
+}
+
+// ListGitPullRequestReview list pr reviews
+func (a *gitPullRequestReviewLister) ListGitPullRequestReview(request *restful.Request, response *restful.Response) {
+	option, err := getPullRequestOptionFromRequest(request)
+	if err != nil {
+		kerrors.HandleError(request, response, err)
+		return
+	}
+	listOption := GetListOptionsFromRequest(request)
+	reviewList, err := a.impl.ListPullRequestReview(request.Request.Context(), option, listOption)
+	if err != nil {
+		kerrors.HandleError(request, response, err)
+		return
+	}
+	response.WriteHeaderAndEntity(http.StatusOK, reviewList)
+}
+
+type gitPullRequestReviewCreator struct {
+	impl client.GitPullRequestReviewCreator
+	tags []string
+}
+
+// NewGitPullRequestReviewCreator create a git pr review submit route with plugin client
+func NewGitPullRequestReviewCreator(impl client.GitPullRequestReviewCreator) Route {
+	return &gitPullRequestReviewCreator{
+		tags: []string{"git", "repositories", "pull request", "review"},
+		impl: impl,
+	}
+}
+
+// Register route
+func (a *gitPullRequestReviewCreator) Register(ws *restful.WebService) {
+	repositoryParam := ws.PathParameter("repository", "pulls belong to repository")
+	projectParam := ws.PathParameter("project", "repository belong to project")
+	indexParam := ws.PathParameter("index", "review belong to index")
+	ws.Route(
+		ws.POST("/projects/{project}/coderepositories/{repository}/pulls/{index}/reviews").To(a.CreateGitPullRequestReview).
+			Doc("CreatePullRequestReview").Param(projectParam).Param(repositoryParam).Param(indexParam).
+			Metadata(restfulspec.KeyOpenAPITags, a.tags).
+			Reads(metav1alpha1.CreatePullRequestReviewParams{}).
+			Returns(http.StatusOK, "OK", metav1alpha1.GitPullRequestReview{}),
+	)
+}
+
+// CreateGitPullRequestReview submit a pr review
+func (a *gitPullRequestReviewCreator) CreateGitPullRequestReview(request *restful.Request, response *restful.Response) {
+	option, err := getPullRequestOptionFromRequest(request)
+	if err != nil {
+		kerrors.HandleError(request, response, err)
+		return
+	}
+	var params metav1alpha1.CreatePullRequestReviewParams
+	if err = request.ReadEntity(&params); err != nil {
+		kerrors.HandleError(request, response, err)
+		return
+	}
+	review, err := a.impl.CreatePullRequestReview(request.Request.Context(), metav1alpha1.CreatePullRequestReviewPayload{
+		GitPullRequestOption:          option,
+		CreatePullRequestReviewParams: params,
+	})
+	if err != nil {
+		kerrors.HandleError(request, response, err)
+		return
+	}
+	response.WriteHeaderAndEntity(http.StatusOK, review)
+}
@@ -0,0 +1,179 @@
+/*
+Copyright 2021 The Katanomi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package route
+
+import (
+	"net/http"
+	"strconv"
+
+	restfulspec "github.com/emicklei/go-restful-openapi/v2"
+	"github.com/emicklei/go-restful/v3"
+	metav1alpha1 "github.com/katanomi/pkg/apis/meta/v1alpha1"
+	kerrors "github.com/katanomi/pkg/errors"
+	"github.com/katanomi/pkg/plugin/client"
+	"k8s.io/apimachinery/pkg/api/errors"
+)
+
+type releaseLister struct {
+	impl client.ReleaseLister
+	tags []string
+}
+
+// NewReleaseLister create a release lister route with plugin client
+func NewReleaseLister(impl client.ReleaseLister) Route {
+	return &releaseLister{
+		tags: []string{"git", "repositories", "release"},
+		impl: impl,
+	}
+}
+
+// Register route
+func (r *releaseLister) Register(ws *restful.WebService) {
+	repositoryParam := ws.PathParameter("repository", "release belong to repository")
+	projectParam := ws.PathParameter("project", "repository belong to project")
+	ws.Route(
+		ListOptionsDocs(
+			ws.GET("/projects/{project}/coderepositories/{repository}/releases").To(r.ListReleases).
+				Doc("ListReleases").Param(projectParam).Param(repositoryParam).
+				Metadata(restfulspec.KeyOpenAPITags, r.tags).
+				Returns(http.StatusOK, "OK", metav1alpha1.GitReleaseList{}),
+		),
+	)
+}
+
+// ListReleases list releases by repo
+func (r *releaseLister) ListReleases(request *restful.Request, response *restful.Response) {
+	option := GetListOptionsFromRequest(request)
+	repo := request.PathParameter("repository")
+	project := request.PathParameter("project")
+	releaseList, err := r.impl.ListReleases(request.Request.Context(), metav1alpha1.GitRepo{Repository: repo, Project: project}, option)
+	if err != nil {
+		kerrors.HandleError(request, response, err)
+		return
+	}
+	response.WriteHeaderAndEntity(http.StatusOK, releaseList)
+}
+
+type releaseCreator struct {
+	impl client.ReleaseCreator
+	tags []string
+}
+
+// NewReleaseCreator create a release create route with plugin client
+func NewReleaseCreator(impl client.ReleaseCreator) Route {
+	return &releaseCreator{
+		tags: []string{"git", "repositories", "release"},
+		impl: impl,
+	}
+}
+
+// Register route
+func (r *releaseCreator) Register(ws *restful.WebService) {
+	repositoryParam := ws.PathParameter("repository", "release belong to repository")
+	projectParam := ws.PathParameter("project", "repository belong to project")
+	ws.Route(
+		ws.POST("/projects/{project}/coderepositories/{repository}/releases").To(r.CreateRelease).
+			Doc("CreateRelease").Param(projectParam).Param(repositoryParam).
+			Metadata(restfulspec.KeyOpenAPITags, r.tags).
+			Reads(metav1alpha1.CreateReleaseParams{}).
+			Returns(http.StatusOK, "OK", metav1alpha1.GitRelease{}),
+	)
+}
+
+// CreateRelease create release
+func (r *releaseCreator) CreateRelease(request *restful.Request, response *restful.Response) {
+	repo := request.PathParameter("repository")
+	project := request.PathParameter("project")
+	var params metav1alpha1.CreateReleaseParams
+	if err := request.ReadEntity(&params); err != nil {
+		kerrors.HandleError(request, response, err)
+		return
+	}
+	payload := metav1alpha1.CreateReleasePayload{GitRepo: metav1alpha1.GitRepo{Repository: repo, Project: project}, CreateReleaseParams: params}
+	releaseObj, err := r.impl.CreateRelease(request.Request.Context(), payload)
+	if err != nil {
+		kerrors.HandleError(request, response, err)
+		return
+	}
+	response.WriteHeaderAndEntity(http.StatusOK, releaseObj)
+}
+
+type releaseAssetUploader struct {
+	impl client.ReleaseAssetUploader
+	tags []string
+}
+
+// NewReleaseAssetUploader create a release asset upload route with plugin client
+func NewReleaseAssetUploader(impl client.ReleaseAssetUploader) Route {
+	return &releaseAssetUploader{
+		tags: []string{"git", "repositories", "release"},
+		impl: impl,
+	}
+}
+
+// Register route
+func (r *releaseAssetUploader) Register(ws *restful.WebService) {
+	repositoryParam := ws.PathParameter("repository", "release belong to repository")
+	projectParam := ws.PathParameter("project", "repository belong to project")
+	releaseParam := ws.PathParameter("release", "release id")
+	nameParam := ws.QueryParameter("name", "asset file name").Required(true)
+	sizeParam := ws.QueryParameter("size", "asset size in bytes, used when the body is chunked").DataType("integer")
+	ws.Route(
+		ws.POST("/projects/{project}/coderepositories/{repository}/releases/{release}/assets").To(r.UploadReleaseAsset).
+			// assets are raw files of any media type
+			Consumes("*/*").
+			Doc("UploadReleaseAsset").Param(projectParam).Param(repositoryParam).Param(releaseParam).Param(nameParam).Param(sizeParam).
+			Metadata(restfulspec.KeyOpenAPITags, r.tags).
+			Returns(http.StatusOK, "OK", metav1alpha1.GitReleaseAsset{}),
+	)
+}
+
+// UploadReleaseAsset upload release asset streaming the request body
+func (r *releaseAssetUploader) UploadReleaseAsset(request *restful.Request, response *restful.Response) {
+	option := metav1alpha1.GitReleaseAssetOption{
+		GitReleaseOption: metav1alpha1.GitReleaseOption{
+			GitRepo: metav1alpha1.GitRepo{
+				Repository: request.PathParameter("repository"),
+				Project:    request.PathParameter("project"),
+			},
+			Release: request.PathParameter("release"),
+		},
+		Name:        request.QueryParameter("name"),
+		ContentType: request.HeaderParameter("Content-Type"),
+		Size:        request.Request.ContentLength,
+	}
+	if option.Name == "" {
+		kerrors.HandleError(request, response, errors.NewBadRequest("asset name is required"))
+		return
+	}
+	if size := request.QueryParameter("size"); size != "" && option.Size < 0 {
+		value, err := strconv.ParseInt(size, 10, 64)
+		if err != nil {
+			kerrors.HandleError(request, response, errors.NewBadRequest("invalid size query parameter: "+err.Error()))
+			return
+		}
+		option.Size = value
+	}
+	defer request.Request.Body.Close()
+
+	asset, err := r.impl.UploadReleaseAsset(request.Request.Context(), option, request.Request.Body)
+	if err != nil {
+		kerrors.HandleError(request, response, err)
+		return
+	}
+	response.WriteHeaderAndEntity(http.StatusOK, asset)
+}
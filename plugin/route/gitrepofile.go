@@ -90,7 +90,7 @@ func (a *gitRepoFileCreator) Register(ws *restful.WebService) {
 	projectParam := ws.PathParameter("project", "repository belong to project")
 	pathParam := ws.PathParameter("filepath", "file path")
 	ws.Route(
-		ws.POST("/projects/{project}/coderepositories/{repository}/content/{filepath}").To(a.CreateGitRepoFile).
+		ws.POST("/projects/{project}/coderepositories/{repository}/content/{filepath:*}").To(a.CreateGitRepoFile).
 			Doc("CreateBranch").Param(projectParam).Param(repositoryParam).Param(pathParam).
 			Metadata(restfulspec.KeyOpenAPITags, a.tags).
 			Returns(http.StatusOK, "OK", metav1alpha1.GitCommit{}),
@@ -119,3 +119,103 @@ func (a *gitRepoFileCreator) CreateGitRepoFile(request *restful.Request, respons
 	}
 	response.WriteHeaderAndEntity(http.StatusOK, commitObject)
 }
+
+type gitRepoFileUpdater struct {
+	impl client.GitRepoFileUpdater
+	tags []string
+}
+
+// NewGitRepoFileUpdater create a git GitRepoFile update route with plugin client
+func NewGitRepoFileUpdater(impl client.GitRepoFileUpdater) Route {
+	return &gitRepoFileUpdater{
+		tags: []string{"git", "repositories", "file"},
+		impl: impl,
+	}
+}
+
+// Register route
+func (a *gitRepoFileUpdater) Register(ws *restful.WebService) {
+	repositoryParam := ws.PathParameter("repository", "file belong to repository")
+	projectParam := ws.PathParameter("project", "repository belong to project")
+	pathParam := ws.PathParameter("filepath", "file path")
+	ws.Route(
+		ws.PUT("/projects/{project}/coderepositories/{repository}/content/{filepath:*}").To(a.UpdateGitRepoFile).
+			Doc("UpdateGitRepoFile").Param(projectParam).Param(repositoryParam).Param(pathParam).
+			Metadata(restfulspec.KeyOpenAPITags, a.tags).
+			Reads(metav1alpha1.UpdateRepoFileParams{}).
+			Returns(http.StatusOK, "OK", metav1alpha1.GitCommit{}),
+	)
+}
+
+// UpdateGitRepoFile update file in repo's one branch
+func (a *gitRepoFileUpdater) UpdateGitRepoFile(request *restful.Request, response *restful.Response) {
+	repo := request.PathParameter("repository")
+	project := request.PathParameter("project")
+	filepath := request.PathParameter("filepath")
+	var params metav1alpha1.UpdateRepoFileParams
+	if err := request.ReadEntity(&params); err != nil {
+		kerrors.HandleError(request, response, err)
+		return
+	}
+	payload := metav1alpha1.UpdateRepoFilePayload{
+		GitRepo:              metav1alpha1.GitRepo{Repository: repo, Project: project},
+		UpdateRepoFileParams: params,
+		FilePath:             filepath,
+	}
+	commitObject, err := a.impl.UpdateGitRepoFile(request.Request.Context(), payload)
+	if err != nil {
+		kerrors.HandleError(request, response, err)
+		return
+	}
+	response.WriteHeaderAndEntity(http.StatusOK, commitObject)
+}
+
+type gitRepoFileDeleter struct {
+	impl client.GitRepoFileDeleter
+	tags []string
+}
+
+// NewGitRepoFileDeleter create a git GitRepoFile delete route with plugin client
+func NewGitRepoFileDeleter(impl client.GitRepoFileDeleter) Route {
+	return &gitRepoFileDeleter{
+		tags: []string{"git", "repositories", "file"},
+		impl: impl,
+	}
+}
+
+// Register route
+func (a *gitRepoFileDeleter) Register(ws *restful.WebService) {
+	repositoryParam := ws.PathParameter("repository", "file belong to repository")
+	projectParam := ws.PathParameter("project", "repository belong to project")
+	pathParam := ws.PathParameter("filepath", "file path")
+	ws.Route(
+		ws.DELETE("/projects/{project}/coderepositories/{repository}/content/{filepath:*}").To(a.DeleteGitRepoFile).
+			Doc("DeleteGitRepoFile").Param(projectParam).Param(repositoryParam).Param(pathParam).
+			Metadata(restfulspec.KeyOpenAPITags, a.tags).
+			Reads(metav1alpha1.DeleteRepoFileParams{}).
+			Returns(http.StatusOK, "OK", metav1alpha1.GitCommit{}),
+	)
+}
+
+// DeleteGitRepoFile delete file in repo's one branch
+func (a *gitRepoFileDeleter) DeleteGitRepoFile(request *restful.Request, response *restful.Response) {
+	repo := request.PathParameter("repository")
+	project := request.PathParameter("project")
+	filepath := request.PathParameter("filepath")
+	var params metav1alpha1.DeleteRepoFileParams
+	if err := request.ReadEntity(&params); err != nil {
+		kerrors.HandleError(request, response, err)
+		return
+	}
+	payload := metav1alpha1.DeleteRepoFilePayload{
+		GitRepo:              metav1alpha1.GitRepo{Repository: repo, Project: project},
+		DeleteRepoFileParams: params,
+		FilePath:             filepath,
+	}
+	commitObject, err := a.impl.DeleteGitRepoFile(request.Request.Context(), payload)
+	if err != nil {
+		kerrors.HandleError(request, response, err)
+		return
+	}
+	response.WriteHeaderAndEntity(http.StatusOK, commitObject)
+}
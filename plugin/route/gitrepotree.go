@@ -0,0 +1,82 @@
+/*
+Copyright 2021 The Katanomi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package route
+
+import (
+	"net/http"
+
+	kerrors "github.com/katanomi/pkg/errors"
+
+	restfulspec "github.com/emicklei/go-restful-openapi/v2"
+	"github.com/emicklei/go-restful/v3"
+	metav1alpha1 "github.com/katanomi/pkg/apis/meta/v1alpha1"
+	"github.com/katanomi/pkg/plugin/client"
+)
+
+type gitRepoTreeLister struct {
+	impl client.GitRepoTreeLister
+	tags []string
+}
+
+// NewGitRepoTreeLister create a git repository tree route with plugin client
+func NewGitRepoTreeLister(impl client.GitRepoTreeLister) Route {
+	return &gitRepoTreeLister{
+		tags: []string{"git", "repositories", "tree"},
+		impl: impl,
+	}
+}
+
+// Register route
+func (a *gitRepoTreeLister) Register(ws *restful.WebService) {
+	repositoryParam := ws.PathParameter("repository", "tree belong to repository")
+	projectParam := ws.PathParameter("project", "repository belong to project")
+	pathParam := ws.QueryParameter("path", "directory path, root directory when empty")
+	refParam := ws.QueryParameter("ref", "tree belong to commit/branch/tag name")
+	recursiveParam := ws.QueryParameter("recursive", "list entries recursively").DataType("boolean")
+	ws.Route(
+		ListOptionsDocs(
+			ws.GET("/projects/{project}/coderepositories/{repository}/tree").To(a.ListGitRepoTree).
+				Doc("ListGitRepoTree").Param(projectParam).Param(repositoryParam).
+				Param(pathParam).Param(refParam).Param(recursiveParam).
+				Metadata(restfulspec.KeyOpenAPITags, a.tags).
+				Returns(http.StatusOK, "OK", metav1alpha1.GitRepoTreeEntryList{}),
+		),
+	)
+}
+
+// ListGitRepoTree list entries of a repository directory
+func (a *gitRepoTreeLister) ListGitRepoTree(request *restful.Request, response *restful.Response) {
+	listOption := GetListOptionsFromRequest(request)
+	repo := request.PathParameter("repository")
+	project := request.PathParameter("project")
+	option := metav1alpha1.GitRepoTreeOption{
+		GitRepo: metav1alpha1.GitRepo{Repository: repo, Project: project},
+		Ref:     request.QueryParameter("ref"),
+		Path:    request.QueryParameter("path"),
+	}
+	var err error
+	if option.Recursive, err = parseBoolQueryParameter(request, "recursive"); err != nil {
+		kerrors.HandleError(request, response, err)
+		return
+	}
+	entries, err := a.impl.ListGitRepoTree(request.Request.Context(), option, listOption)
+	if err != nil {
+		kerrors.HandleError(request, response, err)
+		return
+	}
+	response.WriteHeaderAndEntity(http.StatusOK, entries)
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2021 The Katanomi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package route
+
+import (
+	"net/http"
+
+	kerrors "github.com/katanomi/pkg/errors"
+
+	restfulspec "github.com/emicklei/go-restful-openapi/v2"
+	"github.com/emicklei/go-restful/v3"
+	metav1alpha1 "github.com/katanomi/pkg/apis/meta/v1alpha1"
+	"github.com/katanomi/pkg/plugin/client"
+)
+
+type gitTagLister struct {
+	impl client.GitTagLister
+	tags []string
+}
+
+// NewGitTagLister create a git tag lister route with plugin client
+func NewGitTagLister(impl client.GitTagLister) Route {
+	return &gitTagLister{
+		tags: []string{"git", "repositories", "tag"},
+		impl: impl,
+	}
+}
+
+// Register route
+func (a *gitTagLister) Register(ws *restful.WebService) {
+	repositoryParam := ws.PathParameter("repository", "tag belong to repository")
+	projectParam := ws.PathParameter("project", "repository belong to project")
+	ws.Route(
+		ListOptionsDocs(
+			ws.GET("/projects/{project}/coderepositories/{repository}/tags").To(a.ListTag).
+				Doc("ListTag").Param(projectParam).Param(repositoryParam).
+				Metadata(restfulspec.KeyOpenAPITags, a.tags).
+				Returns(http.StatusOK, "OK", metav1alpha1.GitTagList{}),
+		),
+	)
+}
+
+// ListTag list tag by repo
+func (a *gitTagLister) ListTag(request *restful.Request, response *restful.Response) {
+	option := GetListOptionsFromRequest(request)
+	repo := request.PathParameter("repository")
+	project := request.PathParameter("project")
+	tagList, err := a.impl.ListGitTag(request.Request.Context(), metav1alpha1.GitRepo{Repository: repo, Project: project}, option)
+	if err != nil {
+		kerrors.HandleError(request, response, err)
+		return
+	}
+	response.WriteHeaderAndEntity(http.StatusOK, tagList)
+}
+
+type gitTagCreator struct {
+	impl client.GitTagCreator
+	tags []string
+}
+
+// NewGitTagCreator create a git tag create route with plugin client
+func NewGitTagCreator(impl client.GitTagCreator) Route {
+	return &gitTagCreator{
+		tags: []string{"git", "repositories", "tag"},
+		impl: impl,
+	}
+}
+
+// Register route
+func (a *gitTagCreator) Register(ws *restful.WebService) {
+	repositoryParam := ws.PathParameter("repository", "tag belong to repository")
+	projectParam := ws.PathParameter("project", "repository belong to project")
+	ws.Route(
+		ws.POST("/projects/{project}/coderepositories/{repository}/tags").To(a.CreateTag).
+			Doc("CreateTag").Param(projectParam).Param(repositoryParam).
+			Metadata(restfulspec.KeyOpenAPITags, a.tags).
+			Reads(metav1alpha1.CreateTagParams{}).
+			Returns(http.StatusOK, "OK", metav1alpha1.GitTag{}),
+	)
+}
+
+// CreateTag create tag
+func (a *gitTagCreator) CreateTag(request *restful.Request, response *restful.Response) {
+	repo := request.PathParameter("repository")
+	project := request.PathParameter("project")
+	var params metav1alpha1.CreateTagParams
+	if err := request.ReadEntity(&params); err != nil {
+		kerrors.HandleError(request, response, err)
+		return
+	}
+	payload := metav1alpha1.CreateTagPayload{GitRepo: metav1alpha1.GitRepo{Repository: repo, Project: project}, CreateTagParams: params}
+	gitTagObj, err := a.impl.CreateGitTag(request.Request.Context(), payload)
+	if err != nil {
+		kerrors.HandleError(request, response, err)
+		return
+	}
+	response.WriteHeaderAndEntity(http.StatusOK, gitTagObj)
+}
@@ -0,0 +1,235 @@
+/*
+Copyright 2021 The Katanomi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package route
+
+import (
+	"net/http"
+
+	restfulspec "github.com/emicklei/go-restful-openapi/v2"
+	"github.com/emicklei/go-restful/v3"
+	metav1alpha1 "github.com/katanomi/pkg/apis/meta/v1alpha1"
+	kerrors "github.com/katanomi/pkg/errors"
+	"github.com/katanomi/pkg/plugin/client"
+)
+
+type issueList struct {
+	impl client.IssueLister
+	tags []string
+}
+
+// NewIssueList create a list issue route with plugin client
+func NewIssueList(impl client.IssueLister) Route {
+	return &issueList{
+		tags: []string{"projects", "issues"},
+		impl: impl,
+	}
+}
+
+func (i *issueList) Register(ws *restful.WebService) {
+	ws.Route(
+		ListOptionsDocs(
+			ws.GET("/projects/{project}/issues").To(i.ListIssues).
+				Param(ws.PathParameter("project", "issue belong to project")).
+				Param(ws.QueryParameter("repository", "issue belong to repository, optional")).
+				// docs
+				Doc("ListIssues").
+				Metadata(restfulspec.KeyOpenAPITags, i.tags).
+				Returns(http.StatusOK, "OK", metav1alpha1.IssueList{}),
+		),
+	)
+}
+
+// ListIssues http handler for list issues
+func (i *issueList) ListIssues(request *restful.Request, response *restful.Response) {
+	option := GetListOptionsFromRequest(request)
+	delete(option.Search, "repository")
+	issues, err := i.impl.ListIssues(request.Request.Context(), getIssueOptionsFromRequest(request), option)
+	if err != nil {
+		kerrors.HandleError(request, response, err)
+		return
+	}
+
+	response.WriteHeaderAndEntity(http.StatusOK, issues)
+}
+
+type issueGet struct {
+	impl client.IssueGetter
+	tags []string
+}
+
+// NewIssueGet create a get issue route with plugin client
+func NewIssueGet(impl client.IssueGetter) Route {
+	return &issueGet{
+		tags: []string{"projects", "issues"},
+		impl: impl,
+	}
+}
+
+func (i *issueGet) Register(ws *restful.WebService) {
+	ws.Route(ws.GET("/projects/{project}/issues/{issue}").To(i.GetIssue).
+		Param(ws.PathParameter("project", "issue belong to project")).
+		Param(ws.PathParameter("issue", "issue key")).
+		Param(ws.QueryParameter("repository", "issue belong to repository, optional")).
+		// docs
+		Doc("GetIssue").
+		Metadata(restfulspec.KeyOpenAPITags, i.tags).
+		Returns(http.StatusOK, "OK", metav1alpha1.Issue{}))
+}
+
+// GetIssue http handler for get issue
+func (i *issueGet) GetIssue(request *restful.Request, response *restful.Response) {
+	issue, err := i.impl.GetIssue(request.Request.Context(), getIssueOptionsFromRequest(request))
+	if err != nil {
+		kerrors.HandleError(request, response, err)
+		return
+	}
+
+	response.WriteHeaderAndEntity(http.StatusOK, issue)
+}
+
+type issueCreate struct {
+	impl client.IssueCreator
+	tags []string
+}
+
+// NewIssueCreate create a create issue route with plugin client
+func NewIssueCreate(impl client.IssueCreator) Route {
+	return &issueCreate{
+		tags: []string{"projects", "issues"},
+		impl: impl,
+	}
+}
+
+func (i *issueCreate) Register(ws *restful.WebService) {
+	ws.Route(ws.POST("/projects/{project}/issues").To(i.CreateIssue).
+		Param(ws.PathParameter("project", "issue belong to project")).
+		Param(ws.QueryParameter("repository", "issue belong to repository, optional")).
+		// docs
+		Doc("CreateIssue").
+		Metadata(restfulspec.KeyOpenAPITags, i.tags).
+		Reads(metav1alpha1.CreateIssueParams{}, "CreateIssueParams").
+		Returns(http.StatusCreated, "Issue Created", metav1alpha1.Issue{}))
+}
+
+// CreateIssue http handler for create issue
+func (i *issueCreate) CreateIssue(request *restful.Request, response *restful.Response) {
+	payload := metav1alpha1.CreateIssueParams{}
+	if err := request.ReadEntity(&payload); err != nil {
+		kerrors.HandleError(request, response, err)
+		return
+	}
+
+	issue, err := i.impl.CreateIssue(request.Request.Context(), getIssueOptionsFromRequest(request), payload)
+	if err != nil {
+		kerrors.HandleError(request, response, err)
+		return
+	}
+
+	response.WriteHeaderAndEntity(http.StatusCreated, issue)
+}
+
+type issueUpdate struct {
+	impl client.IssueUpdater
+	tags []string
+}
+
+// NewIssueUpdate create a update issue route with plugin client
+func NewIssueUpdate(impl client.IssueUpdater) Route {
+	return &issueUpdate{
+		tags: []string{"projects", "issues"},
+		impl: impl,
+	}
+}
+
+func (i *issueUpdate) Register(ws *restful.WebService) {
+	ws.Route(ws.PUT("/projects/{project}/issues/{issue}").To(i.UpdateIssue).
+		Param(ws.PathParameter("project", "issue belong to project")).
+		Param(ws.PathParameter("issue", "issue key")).
+		Param(ws.QueryParameter("repository", "issue belong to repository, optional")).
+		// docs
+		Doc("UpdateIssue").
+		Metadata(restfulspec.KeyOpenAPITags, i.tags).
+		Reads(metav1alpha1.UpdateIssueParams{}, "UpdateIssueParams").
+		Returns(http.StatusOK, "OK", metav1alpha1.Issue{}))
+}
+
+// UpdateIssue http handler for update issue
+func (i *issueUpdate) UpdateIssue(request *restful.Request, response *restful.Response) {
+	payload := metav1alpha1.UpdateIssueParams{}
+	if err := request.ReadEntity(&payload); err != nil {
+		kerrors.HandleError(request, response, err)
+		return
+	}
+
+	issue, err := i.impl.UpdateIssue(request.Request.Context(), getIssueOptionsFromRequest(request), payload)
+	if err != nil {
+		kerrors.HandleError(request, response, err)
+		return
+	}
+
+	response.WriteHeaderAndEntity(http.StatusOK, issue)
+}
+
+type issueCommentCreate struct {
+	impl client.IssueCommentCreator
+	tags []string
+}
+
+// NewIssueCommentCreate create a create issue comment route with plugin client
+func NewIssueCommentCreate(impl client.IssueCommentCreator) Route {
+	return &issueCommentCreate{
+		tags: []string{"projects", "issues"},
+		impl: impl,
+	}
+}
+
+func (i *issueCommentCreate) Register(ws *restful.WebService) {
+	ws.Route(ws.POST("/projects/{project}/issues/{issue}/comments").To(i.CreateIssueComment).
+		Param(ws.PathParameter("project", "issue belong to project")).
+		Param(ws.PathParameter("issue", "issue key")).
+		Param(ws.QueryParameter("repository", "issue belong to repository, optional")).
+		// docs
+		Doc("CreateIssueComment").
+		Metadata(restfulspec.KeyOpenAPITags, i.tags).
+		Reads(metav1alpha1.CreateIssueCommentParams{}, "CreateIssueCommentParams").
+		Returns(http.StatusCreated, "Issue Comment Created", metav1alpha1.IssueComment{}))
+}
+
+// CreateIssueComment http handler for create issue comment
+func (i *issueCommentCreate) CreateIssueComment(request *restful.Request, response *restful.Response) {
+	payload := metav1alpha1.CreateIssueCommentParams{}
+	if err := request.ReadEntity(&payload); err != nil {
+		kerrors.HandleError(request, response, err)
+		return
+	}
+
+	comment, err := i.impl.CreateIssueComment(request.Request.Context(), getIssueOptionsFromRequest(request), payload)
+	if err != nil {
+		kerrors.HandleError(request, response, err)
+		return
+	}
+
+	response.WriteHeaderAndEntity(http.StatusCreated, comment)
+}
+
+func getIssueOptionsFromRequest(request *restful.Request) metav1alpha1.IssueOptions {
+	return metav1alpha1.IssueOptions{
+		Project:    request.PathParameter("project"),
+		Repository: request.QueryParameter("repository"),
+		Issue:      request.PathParameter("issue"),
+	}
+}
@@ -23,6 +23,8 @@ import (
 	"github.com/emicklei/go-restful/v3"
 	kerrors "github.com/katanomi/pkg/errors"
 	"github.com/katanomi/pkg/plugin/client"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
 
 	metav1alpha1 "github.com/katanomi/pkg/apis/meta/v1alpha1"
 )
@@ -138,3 +140,207 @@ func (p *projectGet) GetProject(request *restful.Request, response *restful.Resp
 
 	response.WriteHeaderAndEntity(http.StatusOK, resp)
 }
+
+type projectUpdate struct {
+	impl client.ProjectUpdater
+	tags []string
+}
+
+// NewProjectUpdate create a update project route with plugin client
+func NewProjectUpdate(impl client.ProjectUpdater) Route {
+	return &projectUpdate{
+		tags: []string{"projects"},
+		impl: impl,
+	}
+}
+
+func (p *projectUpdate) Register(ws *restful.WebService) {
+	ws.Route(ws.PUT("/projects/{project-id}").To(p.UpdateProject).
+		Param(ws.PathParameter("project-id", "identifier of the project").DataType("string")).
+		// docs
+		Doc("UpdateProject").
+		Metadata(restfulspec.KeyOpenAPITags, p.tags).
+		Reads(metav1alpha1.Project{}, "Project").
+		Returns(http.StatusOK, "Project Updated", metav1alpha1.Project{}))
+}
+
+// UpdateProject http handler for update project
+func (p *projectUpdate) UpdateProject(request *restful.Request, response *restful.Response) {
+	project := &metav1alpha1.Project{}
+	if err := request.ReadEntity(project); err != nil {
+		kerrors.HandleError(request, response, err)
+		return
+	}
+	project.Name = request.PathParameter("project-id")
+
+	resp, err := p.impl.UpdateProject(request.Request.Context(), project)
+	if err != nil {
+		kerrors.HandleError(request, response, err)
+		return
+	}
+
+	response.WriteHeaderAndEntity(http.StatusOK, resp)
+}
+
+type projectDelete struct {
+	impl client.ProjectDeleter
+	tags []string
+}
+
+// NewProjectDelete create a delete project route with plugin client
+func NewProjectDelete(impl client.ProjectDeleter) Route {
+	return &projectDelete{
+		tags: []string{"projects"},
+		impl: impl,
+	}
+}
+
+func (p *projectDelete) Register(ws *restful.WebService) {
+	ws.Route(ws.DELETE("/projects/{project-id}").To(p.DeleteProject).
+		Param(ws.PathParameter("project-id", "identifier of the project").DataType("string")).
+		// docs
+		Doc("DeleteProject").
+		Metadata(restfulspec.KeyOpenAPITags, p.tags).
+		Returns(http.StatusOK, "Project Deleted", nil))
+}
+
+// DeleteProject http handler for delete project
+func (p *projectDelete) DeleteProject(request *restful.Request, response *restful.Response) {
+	id := request.PathParameter("project-id")
+
+	if err := p.impl.DeleteProject(request.Request.Context(), id); err != nil {
+		kerrors.HandleError(request, response, err)
+		return
+	}
+
+	response.WriteHeader(http.StatusOK)
+}
+
+type projectMemberList struct {
+	impl client.ProjectMemberLister
+	tags []string
+}
+
+// NewProjectMemberList create a list project member route with plugin client
+func NewProjectMemberList(impl client.ProjectMemberLister) Route {
+	return &projectMemberList{
+		tags: []string{"projects"},
+		impl: impl,
+	}
+}
+
+func (p *projectMemberList) Register(ws *restful.WebService) {
+	ws.Route(
+		ListOptionsDocs(
+			ws.GET("/projects/{project-id}/members").To(p.ListProjectMembers).
+				Param(ws.PathParameter("project-id", "identifier of the project").DataType("string")).
+				// docs
+				Doc("ListProjectMembers").
+				Metadata(restfulspec.KeyOpenAPITags, p.tags).
+				Returns(http.StatusOK, "OK", metav1alpha1.ProjectMemberList{}),
+		),
+	)
+}
+
+// ListProjectMembers http handler for list project members
+func (p *projectMemberList) ListProjectMembers(request *restful.Request, response *restful.Response) {
+	id := request.PathParameter("project-id")
+	option := GetListOptionsFromRequest(request)
+	members, err := p.impl.ListProjectMembers(request.Request.Context(), id, option)
+	if err != nil {
+		kerrors.HandleError(request, response, err)
+		return
+	}
+
+	response.WriteHeaderAndEntity(http.StatusOK, members)
+}
+
+type projectMemberAdd struct {
+	impl client.ProjectMemberAdder
+	tags []string
+}
+
+// NewProjectMemberAdd create a add project member route with plugin client
+func NewProjectMemberAdd(impl client.ProjectMemberAdder) Route {
+	return &projectMemberAdd{
+		tags: []string{"projects"},
+		impl: impl,
+	}
+}
+
+func (p *projectMemberAdd) Register(ws *restful.WebService) {
+	ws.Route(ws.POST("/projects/{project-id}/members").To(p.AddProjectMember).
+		Param(ws.PathParameter("project-id", "identifier of the project").DataType("string")).
+		// docs
+		Doc("AddProjectMember").
+		Metadata(restfulspec.KeyOpenAPITags, p.tags).
+		Reads(metav1alpha1.ProjectMember{}, "ProjectMember").
+		Returns(http.StatusCreated, "Project Member Added", metav1alpha1.ProjectMember{}))
+}
+
+// AddProjectMember http handler for add project member
+func (p *projectMemberAdd) AddProjectMember(request *restful.Request, response *restful.Response) {
+	id := request.PathParameter("project-id")
+	member := &metav1alpha1.ProjectMember{}
+	if err := request.ReadEntity(member); err != nil {
+		kerrors.HandleError(request, response, err)
+		return
+	}
+
+	resp, err := p.impl.AddProjectMember(request.Request.Context(), id, member)
+	if err != nil {
+		kerrors.HandleError(request, response, err)
+		return
+	}
+
+	response.WriteHeaderAndEntity(http.StatusCreated, resp)
+}
+
+type projectMemberRemove struct {
+	impl client.ProjectMemberRemover
+	tags []string
+}
+
+// NewProjectMemberRemove create a remove project member route with plugin client
+func NewProjectMemberRemove(impl client.ProjectMemberRemover) Route {
+	return &projectMemberRemove{
+		tags: []string{"projects"},
+		impl: impl,
+	}
+}
+
+func (p *projectMemberRemove) Register(ws *restful.WebService) {
+	ws.Route(ws.DELETE("/projects/{project-id}/members").To(p.RemoveProjectMember).
+		Param(ws.PathParameter("project-id", "identifier of the project").DataType("string")).
+		// member names may contain slashes, e.g. gitlab subgroups
+		Param(ws.QueryParameter("member", "name of the user or group").DataType("string").Required(true)).
+		Param(ws.QueryParameter("kind", "kind of the member, User or Group").DataType("string").DefaultValue(rbacv1.UserKind)).
+		// docs
+		Doc("RemoveProjectMember").
+		Metadata(restfulspec.KeyOpenAPITags, p.tags).
+		Returns(http.StatusOK, "Project Member Removed", nil).
+		Returns(http.StatusBadRequest, "Member is empty", nil))
+}
+
+// RemoveProjectMember http handler for remove project member
+func (p *projectMemberRemove) RemoveProjectMember(request *restful.Request, response *restful.Response) {
+	id := request.PathParameter("project-id")
+	subject := rbacv1.Subject{
+		Kind: request.QueryParameter("kind"),
+		Name: request.QueryParameter("member"),
+	}
+	if subject.Name == "" {
+		kerrors.HandleError(request, response, errors.NewBadRequest("member query parameter is required"))
+		return
+	}
+	if subject.Kind == "" {
+		subject.Kind = rbacv1.UserKind
+	}
+
+	if err := p.impl.RemoveProjectMember(request.Request.Context(), id, subject); err != nil {
+		kerrors.HandleError(request, response, err)
+		return
+	}
+
+	response.WriteHeader(http.StatusOK)
+}
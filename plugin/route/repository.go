@@ -66,3 +66,86 @@ func (r *repositoryList) ListRepositories(request *restful.Request, response *re
 
 	response.WriteHeaderAndEntity(http.StatusOK, repositories)
 }
+
+type repositoryCreate struct {
+	impl client.RepositoryCreator
+	tags []string
+}
+
+// NewRepositoryCreate create a create repository route with plugin client
+func NewRepositoryCreate(impl client.RepositoryCreator) Route {
+	return &repositoryCreate{
+		tags: []string{"projects", "repositories"},
+		impl: impl,
+	}
+}
+
+func (r *repositoryCreate) Register(ws *restful.WebService) {
+	projectParam := ws.PathParameter("project", "repository belong to integraion")
+	ws.Route(
+		ws.POST("/projects/{project}/repositories").To(r.CreateRepository).
+			// docs
+			Doc("CreateRepository").Param(projectParam).
+			Metadata(restfulspec.KeyOpenAPITags, r.tags).
+			Reads(metav1alpha1.RepositoryCreateOptions{}, "RepositoryCreateOptions").
+			Returns(http.StatusCreated, "Repository Created", metav1alpha1.Repository{}),
+	)
+}
+
+// CreateRepository http handler for create repository
+func (r *repositoryCreate) CreateRepository(request *restful.Request, response *restful.Response) {
+	pathParams := metav1alpha1.RepositoryOptions{
+		Project: request.PathParameter("project"),
+	}
+	option := metav1alpha1.RepositoryCreateOptions{}
+	if err := request.ReadEntity(&option); err != nil {
+		kerrors.HandleError(request, response, err)
+		return
+	}
+	repository, err := r.impl.CreateRepository(request.Request.Context(), pathParams, option)
+	if err != nil {
+		kerrors.HandleError(request, response, err)
+		return
+	}
+
+	response.WriteHeaderAndEntity(http.StatusCreated, repository)
+}
+
+type repositoryDelete struct {
+	impl client.RepositoryDeleter
+	tags []string
+}
+
+// NewRepositoryDelete create a delete repository route with plugin client
+func NewRepositoryDelete(impl client.RepositoryDeleter) Route {
+	return &repositoryDelete{
+		tags: []string{"projects", "repositories"},
+		impl: impl,
+	}
+}
+
+func (r *repositoryDelete) Register(ws *restful.WebService) {
+	projectParam := ws.PathParameter("project", "repository belong to integraion")
+	repositoryParam := ws.PathParameter("repository", "repository name")
+	ws.Route(
+		ws.DELETE("/projects/{project}/repositories/{repository}").To(r.DeleteRepository).
+			// docs
+			Doc("DeleteRepository").Param(projectParam).Param(repositoryParam).
+			Metadata(restfulspec.KeyOpenAPITags, r.tags).
+			Returns(http.StatusOK, "OK", nil),
+	)
+}
+
+// DeleteRepository http handler for delete repository
+func (r *repositoryDelete) DeleteRepository(request *restful.Request, response *restful.Response) {
+	pathParams := metav1alpha1.RepositoryOptions{
+		Project: request.PathParameter("project"),
+	}
+	err := r.impl.DeleteRepository(request.Request.Context(), pathParams, request.PathParameter("repository"))
+	if err != nil {
+		kerrors.HandleError(request, response, err)
+		return
+	}
+
+	response.WriteHeader(http.StatusOK)
+}
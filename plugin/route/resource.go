@@ -23,6 +23,7 @@ import (
 	"github.com/emicklei/go-restful/v3"
 	kerrors "github.com/katanomi/pkg/errors"
 	"github.com/katanomi/pkg/plugin/client"
+	"k8s.io/apimachinery/pkg/api/errors"
 
 	metav1alpha1 "github.com/katanomi/pkg/apis/meta/v1alpha1"
 )
@@ -61,3 +62,96 @@ func (r *resourceList) ResourceList(request *restful.Request, response *restful.
 
 	response.WriteHeaderAndEntity(http.StatusOK, resources)
 }
+
+type resourceGet struct {
+	impl client.ResourceGetter
+	tags []string
+}
+
+// NewResourceGet create a get resource route with plugin client
+func NewResourceGet(impl client.ResourceGetter) Route {
+	return &resourceGet{
+		tags: []string{"resources"},
+		impl: impl,
+	}
+}
+
+func (r *resourceGet) Register(ws *restful.WebService) {
+	ws.Route(ws.GET("/resources/{subType}").To(r.GetResource).
+		Param(ws.PathParameter("subType", "sub type of the resource").DataType("string")).
+		Param(ws.QueryParameter("id", "identifier of the resource, may contain \"/\"").DataType("string").Required(true)).
+		// docs
+		Doc("GetResource").
+		Metadata(restfulspec.KeyOpenAPITags, r.tags).
+		Returns(http.StatusOK, "OK", metav1alpha1.Resource{}))
+}
+
+// GetResource http handler for get resource
+func (r *resourceGet) GetResource(request *restful.Request, response *restful.Response) {
+	params, err := getResourceOptionsFromRequest(request)
+	if err != nil {
+		kerrors.HandleError(request, response, err)
+		return
+	}
+	resource, err := r.impl.GetResource(request.Request.Context(), params)
+	if err != nil {
+		kerrors.HandleError(request, response, err)
+		return
+	}
+
+	response.WriteHeaderAndEntity(http.StatusOK, resource)
+}
+
+type resourceChildrenList struct {
+	impl client.ResourceChildrenLister
+	tags []string
+}
+
+// NewResourceChildrenList create a list resource children route with plugin client
+func NewResourceChildrenList(impl client.ResourceChildrenLister) Route {
+	return &resourceChildrenList{
+		tags: []string{"resources"},
+		impl: impl,
+	}
+}
+
+func (r *resourceChildrenList) Register(ws *restful.WebService) {
+	ws.Route(
+		ListOptionsDocs(ws.GET("/resources/{subType}/children").To(r.ListResourceChildren).
+			Param(ws.PathParameter("subType", "sub type of the resource").DataType("string")).
+			Param(ws.QueryParameter("id", "identifier of the resource, may contain \"/\"").DataType("string").Required(true)).
+			// docs
+			Doc("ListResourceChildren").
+			Metadata(restfulspec.KeyOpenAPITags, r.tags).
+			Returns(http.StatusOK, "OK", metav1alpha1.ResourceList{})))
+}
+
+// ListResourceChildren http handler for list children of a resource
+func (r *resourceChildrenList) ListResourceChildren(request *restful.Request, response *restful.Response) {
+	params, err := getResourceOptionsFromRequest(request)
+	if err != nil {
+		kerrors.HandleError(request, response, err)
+		return
+	}
+	option := GetListOptionsFromRequest(request)
+	resources, err := r.impl.ListResourceChildren(request.Request.Context(), params, option)
+	if err != nil {
+		kerrors.HandleError(request, response, err)
+		return
+	}
+
+	response.WriteHeaderAndEntity(http.StatusOK, resources)
+}
+
+// getResourceOptionsFromRequest returns ResourceOptions based on path and query parameters
+// the id is read from the query as hierarchical ids may contain "/"
+func getResourceOptionsFromRequest(request *restful.Request) (metav1alpha1.ResourceOptions, error) {
+	params := metav1alpha1.ResourceOptions{
+		SubType: metav1alpha1.ResourceSubType(request.PathParameter("subType")),
+		ID:      request.QueryParameter("id"),
+	}
+	if params.ID == "" {
+		return params, errors.NewBadRequest("id query parameter is required")
+	}
+	return params, nil
+}
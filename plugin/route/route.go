@@ -59,14 +59,58 @@ func match(c client.Interface) []Route {
 		routes = append(routes, NewProjectGet(v))
 	}
 
+	if v, ok := c.(client.ProjectUpdater); ok {
+		routes = append(routes, NewProjectUpdate(v))
+	}
+
+	if v, ok := c.(client.ProjectDeleter); ok {
+		routes = append(routes, NewProjectDelete(v))
+	}
+
+	if v, ok := c.(client.ProjectMemberLister); ok {
+		routes = append(routes, NewProjectMemberList(v))
+	}
+
+	if v, ok := c.(client.ProjectMemberAdder); ok {
+		routes = append(routes, NewProjectMemberAdd(v))
+	}
+
+	if v, ok := c.(client.ProjectMemberRemover); ok {
+		routes = append(routes, NewProjectMemberRemove(v))
+	}
+
+	if v, ok := c.(client.UserGetter); ok {
+		routes = append(routes, NewUserGet(v))
+	}
+
+	if v, ok := c.(client.UserLister); ok {
+		routes = append(routes, NewUserList(v))
+	}
+
 	if v, ok := c.(client.ResourceLister); ok {
 		routes = append(routes, NewResourceList(v))
 	}
 
+	if v, ok := c.(client.ResourceGetter); ok {
+		routes = append(routes, NewResourceGet(v))
+	}
+
+	if v, ok := c.(client.ResourceChildrenLister); ok {
+		routes = append(routes, NewResourceChildrenList(v))
+	}
+
 	if v, ok := c.(client.RepositoryLister); ok {
 		routes = append(routes, NewRepositoryList(v))
 	}
 
+	if v, ok := c.(client.RepositoryCreator); ok {
+		routes = append(routes, NewRepositoryCreate(v))
+	}
+
+	if v, ok := c.(client.RepositoryDeleter); ok {
+		routes = append(routes, NewRepositoryDelete(v))
+	}
+
 	if v, ok := c.(client.ArtifactLister); ok {
 		routes = append(routes, NewArtifactList(v))
 	}
@@ -79,10 +123,34 @@ func match(c client.Interface) []Route {
 		routes = append(routes, NewArtifactDelete(v))
 	}
 
+	if v, ok := c.(client.ArtifactTagLister); ok {
+		routes = append(routes, NewArtifactTagList(v))
+	}
+
+	if v, ok := c.(client.ArtifactCopier); ok {
+		routes = append(routes, NewArtifactCopy(v))
+	}
+
 	if v, ok := c.(client.ScanImage); ok {
 		routes = append(routes, NewScanImage(v))
 	}
 
+	if v, ok := c.(client.ScanReportGetter); ok {
+		routes = append(routes, NewScanReportGet(v))
+	}
+
+	if v, ok := c.(client.WebhookRegister); ok {
+		routes = append(routes, NewWebhookRegister(v))
+	}
+
+	if v, ok := c.(client.WebhookResourceDiffer); ok {
+		routes = append(routes, NewWebhookResourceDiffer(v))
+	}
+
+	if v, ok := c.(client.WebhookReceiver); ok {
+		routes = append(routes, NewWebhookReceiver(v))
+	}
+
 	if v, ok := c.(client.GitRepoFileGetter); ok {
 		routes = append(routes, NewGitRepoFileGetter(v))
 	}
@@ -91,6 +159,18 @@ func match(c client.Interface) []Route {
 		routes = append(routes, NewGitRepoFileCreator(v))
 	}
 
+	if v, ok := c.(client.GitRepoFileUpdater); ok {
+		routes = append(routes, NewGitRepoFileUpdater(v))
+	}
+
+	if v, ok := c.(client.GitRepoFileDeleter); ok {
+		routes = append(routes, NewGitRepoFileDeleter(v))
+	}
+
+	if v, ok := c.(client.GitRepoTreeLister); ok {
+		routes = append(routes, NewGitRepoTreeLister(v))
+	}
+
 	if v, ok := c.(client.GitBranchLister); ok {
 		routes = append(routes, NewGitBranchLister(v))
 	}
@@ -99,10 +179,42 @@ func match(c client.Interface) []Route {
 		routes = append(routes, NewGitBranchCreator(v))
 	}
 
+	if v, ok := c.(client.GitBranchDeleter); ok {
+		routes = append(routes, NewGitBranchDeleter(v))
+	}
+
+	if v, ok := c.(client.GitBranchProtectionHandler); ok {
+		routes = append(routes, NewGitBranchProtectionHandler(v))
+	}
+
+	if v, ok := c.(client.GitTagLister); ok {
+		routes = append(routes, NewGitTagLister(v))
+	}
+
+	if v, ok := c.(client.GitTagCreator); ok {
+		routes = append(routes, NewGitTagCreator(v))
+	}
+
 	if v, ok := c.(client.GitCommitGetter); ok {
 		routes = append(routes, NewGitCommitGetter(v))
 	}
 
+	if v, ok := c.(client.GitCommitLister); ok {
+		routes = append(routes, NewGitCommitLister(v))
+	}
+
+	if v, ok := c.(client.GitCommitStatusLister); ok {
+		routes = append(routes, NewGitCommitStatusLister(v))
+	}
+
+	if v, ok := c.(client.GitCommitStatusCreator); ok {
+		routes = append(routes, NewGitCommitStatusCreator(v))
+	}
+
+	if v, ok := c.(client.GitCompareGetter); ok {
+		routes = append(routes, NewGitCompareGetter(v))
+	}
+
 	if v, ok := c.(client.GitPullRequestHandler); ok {
 		routes = append(routes, NewGitPullRequestLister(v))
 	}
@@ -111,6 +223,86 @@ func match(c client.Interface) []Route {
 		routes = append(routes, NewGitPullRequestNoteCreator(v))
 	}
 
+	if v, ok := c.(client.GitPullRequestCommentLister); ok {
+		routes = append(routes, NewGitPullRequestNoteLister(v))
+	}
+
+	if v, ok := c.(client.GitPullRequestCommentUpdater); ok {
+		routes = append(routes, NewGitPullRequestNoteUpdater(v))
+	}
+
+	if v, ok := c.(client.GitPullRequestCommentDeleter); ok {
+		routes = append(routes, NewGitPullRequestNoteDeleter(v))
+	}
+
+	if v, ok := c.(client.GitPullRequestMerger); ok {
+		routes = append(routes, NewGitPullRequestMerger(v))
+	}
+
+	if v, ok := c.(client.GitPullRequestCloser); ok {
+		routes = append(routes, NewGitPullRequestCloser(v))
+	}
+
+	if v, ok := c.(client.GitPullRequestReopener); ok {
+		routes = append(routes, NewGitPullRequestReopener(v))
+	}
+
+	if v, ok := c.(client.GitPullRequestUpdater); ok {
+		routes = append(routes, NewGitPullRequestUpdater(v))
+	}
+
+	if v, ok := c.(client.GitPullRequestCommitLister); ok {
+		routes = append(routes, NewGitPullRequestCommitLister(v))
+	}
+
+	if v, ok := c.(client.GitPullRequestFileLister); ok {
+		routes = append(routes, NewGitPullRequestFileLister(v))
+	}
+
+	if v, ok := c.(client.GitPullRequestReviewLister); ok {
+		routes = append(routes, NewGitPullRequestReviewLister(v))
+	}
+
+	if v, ok := c.(client.GitPullRequestReviewCreator); ok {
+		routes = append(routes, NewGitPullRequestReviewCreator(v))
+	}
+
+	if v, ok := c.(client.GitCodeSearcher); ok {
+		routes = append(routes, NewGitCodeSearcher(v))
+	}
+
+	if v, ok := c.(client.ReleaseLister); ok {
+		routes = append(routes, NewReleaseLister(v))
+	}
+
+	if v, ok := c.(client.ReleaseCreator); ok {
+		routes = append(routes, NewReleaseCreator(v))
+	}
+
+	if v, ok := c.(client.ReleaseAssetUploader); ok {
+		routes = append(routes, NewReleaseAssetUploader(v))
+	}
+
+	if v, ok := c.(client.IssueLister); ok {
+		routes = append(routes, NewIssueList(v))
+	}
+
+	if v, ok := c.(client.IssueGetter); ok {
+		routes = append(routes, NewIssueGet(v))
+	}
+
+	if v, ok := c.(client.IssueCreator); ok {
+		routes = append(routes, NewIssueCreate(v))
+	}
+
+	if v, ok := c.(client.IssueUpdater); ok {
+		routes = append(routes, NewIssueUpdate(v))
+	}
+
+	if v, ok := c.(client.IssueCommentCreator); ok {
+		routes = append(routes, NewIssueCommentCreate(v))
+	}
+
 	return routes
 }
 
@@ -124,12 +316,45 @@ func GetMethods(c client.Interface) []string {
 	if _, ok := c.(client.ProjectCreator); ok {
 		methods = append(methods, "CreateProject")
 	}
+	if _, ok := c.(client.ProjectUpdater); ok {
+		methods = append(methods, "UpdateProject")
+	}
+	if _, ok := c.(client.ProjectDeleter); ok {
+		methods = append(methods, "DeleteProject")
+	}
+	if _, ok := c.(client.ProjectMemberLister); ok {
+		methods = append(methods, "ListProjectMembers")
+	}
+	if _, ok := c.(client.ProjectMemberAdder); ok {
+		methods = append(methods, "AddProjectMember")
+	}
+	if _, ok := c.(client.ProjectMemberRemover); ok {
+		methods = append(methods, "RemoveProjectMember")
+	}
+	if _, ok := c.(client.UserGetter); ok {
+		methods = append(methods, "GetCurrentUser")
+	}
+	if _, ok := c.(client.UserLister); ok {
+		methods = append(methods, "ListUsers")
+	}
 	if _, ok := c.(client.ResourceLister); ok {
 		methods = append(methods, "ListResources")
 	}
+	if _, ok := c.(client.ResourceGetter); ok {
+		methods = append(methods, "GetResource")
+	}
+	if _, ok := c.(client.ResourceChildrenLister); ok {
+		methods = append(methods, "ListResourceChildren")
+	}
 	if _, ok := c.(client.RepositoryLister); ok {
 		methods = append(methods, "ListRepositories")
 	}
+	if _, ok := c.(client.RepositoryCreator); ok {
+		methods = append(methods, "CreateRepository")
+	}
+	if _, ok := c.(client.RepositoryDeleter); ok {
+		methods = append(methods, "DeleteRepository")
+	}
 	if _, ok := c.(client.ArtifactLister); ok {
 		methods = append(methods, "ListArtifacts")
 	}
@@ -139,9 +364,18 @@ func GetMethods(c client.Interface) []string {
 	if _, ok := c.(client.ArtifactDeleter); ok {
 		methods = append(methods, "DeleteArtifact")
 	}
+	if _, ok := c.(client.ArtifactTagLister); ok {
+		methods = append(methods, "ListArtifactTags")
+	}
+	if _, ok := c.(client.ArtifactCopier); ok {
+		methods = append(methods, "CopyArtifact")
+	}
 	if _, ok := c.(client.ScanImage); ok {
 		methods = append(methods, "ScanImage")
 	}
+	if _, ok := c.(client.ScanReportGetter); ok {
+		methods = append(methods, "GetScanReport")
+	}
 	if _, ok := c.(client.WebhookRegister); ok {
 		methods = append(methods, "CreateWebhook", "UpdateWebhook", "DeleteWebhook")
 	}
@@ -157,21 +391,114 @@ func GetMethods(c client.Interface) []string {
 	if _, ok := c.(client.GitRepoFileCreator); ok {
 		methods = append(methods, "CreateGitRepoFile")
 	}
+	if _, ok := c.(client.GitRepoFileUpdater); ok {
+		methods = append(methods, "UpdateGitRepoFile")
+	}
+	if _, ok := c.(client.GitRepoFileDeleter); ok {
+		methods = append(methods, "DeleteGitRepoFile")
+	}
+	if _, ok := c.(client.GitRepoTreeLister); ok {
+		methods = append(methods, "ListGitRepoTree")
+	}
 	if _, ok := c.(client.GitBranchLister); ok {
 		methods = append(methods, "ListGitBranch")
 	}
 	if _, ok := c.(client.GitBranchCreator); ok {
 		methods = append(methods, "CreateGitBranch")
 	}
+	if _, ok := c.(client.GitBranchDeleter); ok {
+		methods = append(methods, "DeleteGitBranch")
+	}
+	if _, ok := c.(client.GitBranchProtectionHandler); ok {
+		methods = append(methods, "ProtectGitBranch", "UnprotectGitBranch")
+	}
+	if _, ok := c.(client.GitTagLister); ok {
+		methods = append(methods, "ListGitTag")
+	}
+	if _, ok := c.(client.GitTagCreator); ok {
+		methods = append(methods, "CreateGitTag")
+	}
 	if _, ok := c.(client.GitCommitGetter); ok {
 		methods = append(methods, "GetGitCommit")
 	}
+	if _, ok := c.(client.GitCommitLister); ok {
+		methods = append(methods, "ListGitCommit")
+	}
+	if _, ok := c.(client.GitCommitStatusLister); ok {
+		methods = append(methods, "ListGitCommitStatus")
+	}
+	if _, ok := c.(client.GitCommitStatusCreator); ok {
+		methods = append(methods, "CreateGitCommitStatus")
+	}
+	if _, ok := c.(client.GitCompareGetter); ok {
+		methods = append(methods, "GetGitCompare")
+	}
 	if _, ok := c.(client.GitPullRequestHandler); ok {
 		methods = append(methods, "ListGitPullRequest", "GetGitPullRequest", "CreatePullRequest")
 	}
 	if _, ok := c.(client.GitPullRequestCommentCreator); ok {
 		methods = append(methods, "CreatePullRequestComment")
 	}
+	if _, ok := c.(client.GitPullRequestCommentLister); ok {
+		methods = append(methods, "ListPullRequestComment")
+	}
+	if _, ok := c.(client.GitPullRequestCommentUpdater); ok {
+		methods = append(methods, "UpdatePullRequestComment")
+	}
+	if _, ok := c.(client.GitPullRequestCommentDeleter); ok {
+		methods = append(methods, "DeletePullRequestComment")
+	}
+	if _, ok := c.(client.GitPullRequestMerger); ok {
+		methods = append(methods, "MergePullRequest")
+	}
+	if _, ok := c.(client.GitPullRequestCloser); ok {
+		methods = append(methods, "ClosePullRequest")
+	}
+	if _, ok := c.(client.GitPullRequestReopener); ok {
+		methods = append(methods, "ReopenPullRequest")
+	}
+	if _, ok := c.(client.GitPullRequestUpdater); ok {
+		methods = append(methods, "UpdatePullRequest")
+	}
+	if _, ok := c.(client.GitPullRequestCommitLister); ok {
+		methods = append(methods, "ListPullRequestCommits")
+	}
+	if _, ok := c.(client.GitPullRequestFileLister); ok {
+		methods = append(methods, "ListPullRequestFiles")
+	}
+	if _, ok := c.(client.GitPullRequestReviewLister); ok {
+		methods = append(methods, "ListPullRequestReview")
+	}
+	if _, ok := c.(client.GitPullRequestReviewCreator); ok {
+		methods = append(methods, "CreatePullRequestReview")
+	}
+	if _, ok := c.(client.GitCodeSearcher); ok {
+		methods = append(methods, "SearchGitCode")
+	}
+	if _, ok := c.(client.ReleaseLister); ok {
+		methods = append(methods, "ListReleases")
+	}
+	if _, ok := c.(client.ReleaseCreator); ok {
+		methods = append(methods, "CreateRelease")
+	}
+	if _, ok := c.(client.ReleaseAssetUploader); ok {
+		methods = append(methods, "UploadReleaseAsset")
+	}
+	if _, ok := c.(client.IssueLister); ok {
+		methods = append(methods, "ListIssues")
+	}
+	if _, ok := c.(client.IssueGetter); ok {
+		methods = append(methods, "GetIssue")
+	}
+	if _, ok := c.(client.IssueCreator); ok {
+		methods = append(methods, "CreateIssue")
+	}
+	if _, ok := c.(client.IssueUpdater); ok {
+		methods = append(methods, "UpdateIssue")
+	}
+	if _, ok := c.(client.IssueCommentCreator); ok {
+		methods = append(methods, "CreateIssueComment")
+	}
 	return methods
 }
 
@@ -21,15 +21,23 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/emicklei/go-restful/v3"
 	metav1alpha1 "github.com/katanomi/pkg/apis/meta/v1alpha1"
 	"github.com/katanomi/pkg/plugin/client"
 	. "github.com/onsi/gomega"
 	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -54,6 +62,34 @@ func TestMatch(t *testing.T) {
 			c:   &TestProjectListCreate{},
 			len: 2,
 		},
+		{
+			c:   &TestGitTag{},
+			len: 2,
+		},
+		{
+			c:   &TestProjectMember{},
+			len: 3,
+		},
+		{
+			c:   &TestUser{},
+			len: 2,
+		},
+		{
+			c:   &TestRepository{},
+			len: 2,
+		},
+		{
+			c:   &TestProjectUpdateDelete{},
+			len: 2,
+		},
+		{
+			c:   &TestIssue{},
+			len: 5,
+		},
+		{
+			c:   &TestRelease{},
+			len: 3,
+		},
 	}
 
 	g := NewGomegaWithT(t)
@@ -87,6 +123,38 @@ func TestGetMethods(t *testing.T) {
 			c:       &TestProjectListCreate{},
 			methods: []string{"ListProjects", "CreateProject"},
 		},
+		{
+			c:       &TestGitTag{},
+			methods: []string{"ListGitTag", "CreateGitTag"},
+		},
+		{
+			c:       &TestProjectMember{},
+			methods: []string{"ListProjectMembers", "AddProjectMember", "RemoveProjectMember"},
+		},
+		{
+			c:       &TestUser{},
+			methods: []string{"GetCurrentUser", "ListUsers"},
+		},
+		{
+			c:       &TestRepository{},
+			methods: []string{"CreateRepository", "DeleteRepository"},
+		},
+		{
+			c:       &TestProjectUpdateDelete{},
+			methods: []string{"UpdateProject", "DeleteProject"},
+		},
+		{
+			c:       &TestIssue{},
+			methods: []string{"ListIssues", "GetIssue", "CreateIssue", "UpdateIssue", "CreateIssueComment"},
+		},
+		{
+			c:       &TestRelease{},
+			methods: []string{"ListReleases", "CreateRelease", "UploadReleaseAsset"},
+		},
+		{
+			c:       &TestGitCodeSearcher{},
+			methods: []string{"SearchGitCode"},
+		},
 	}
 
 	g := NewGomegaWithT(t)
@@ -232,87 +300,1661 @@ func TestProjectGet(t *testing.T) {
 	g.Expect(project.Name).To(Equal("1"))
 }
 
-type TestProjectList struct {
-}
+func TestProjectMemberRemove(t *testing.T) {
+	testCases := map[string]struct {
+		query  string
+		code   int
+		member string
+		kind   string
+	}{
+		"default kind":     {query: "?member=dev", code: http.StatusOK, member: "dev", kind: rbacv1.UserKind},
+		"group kind":       {query: "?member=dev&kind=Group", code: http.StatusOK, member: "dev", kind: rbacv1.GroupKind},
+		"group with slash": {query: "?member=" + url.QueryEscape("group/subgroup") + "&kind=Group", code: http.StatusOK, member: "group/subgroup", kind: rbacv1.GroupKind},
+		"missing member":   {query: "?kind=Group", code: http.StatusBadRequest},
+	}
 
-func (t *TestProjectList) Path() string {
-	return "test-1"
-}
+	for name, item := range testCases {
+		test := item
+		t.Run(name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
 
-func (t *TestProjectList) Setup(_ context.Context, _ *zap.SugaredLogger) error {
-	return nil
-}
+			impl := &TestProjectMember{}
+			ws, err := NewService(impl)
+			g.Expect(err).To(BeNil())
 
-func (t *TestProjectList) ListProjects(ctx context.Context, option metav1alpha1.ListOptions) (*metav1alpha1.ProjectList, error) {
-	return &metav1alpha1.ProjectList{
-		Items: []metav1alpha1.Project{
-			{
-				TypeMeta: metav1.TypeMeta{
-					Kind: "1",
-				},
-			},
-			{
-				TypeMeta: metav1.TypeMeta{
-					Kind: "2",
-				},
-			},
-		},
-	}, nil
-}
+			container := restful.NewContainer()
+			container.Add(ws)
 
-type TestProjectCreate struct {
+			httpRequest, _ := http.NewRequest("DELETE", "/plugins/v1alpha1/test-8/projects/1/members"+test.query, nil)
+			httpRequest.Header.Set("Accept", "application/json")
+
+			httpWriter := httptest.NewRecorder()
+
+			container.Dispatch(httpWriter, httpRequest)
+			g.Expect(httpWriter.Code).To(Equal(test.code))
+			if test.code == http.StatusOK {
+				g.Expect(impl.removed).To(Equal(rbacv1.Subject{Kind: test.kind, Name: test.member}))
+			}
+		})
+	}
 }
 
-func (t *TestProjectCreate) Path() string {
-	return "test-2"
+func TestUserGet(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ws, err := NewService(&TestUser{}, client.AuthFilter)
+	g.Expect(err).To(BeNil())
+
+	container := restful.NewContainer()
+	container.Add(ws)
+
+	httpRequest, _ := http.NewRequest("GET", "/plugins/v1alpha1/test-9/user", nil)
+	httpRequest.Header.Set("Accept", "application/json")
+	httpRequest.Header.Set(client.PluginAuthHeader, string(metav1alpha1.AuthTypeBasic))
+	data, _ := json.Marshal(map[string][]byte{"username": []byte("dev"), "password": []byte("pwd")})
+	httpRequest.Header.Set(client.PluginSecretHeader, base64.StdEncoding.EncodeToString(data))
+
+	httpWriter := httptest.NewRecorder()
+
+	container.Dispatch(httpWriter, httpRequest)
+	g.Expect(httpWriter.Code).To(Equal(http.StatusOK))
+
+	user := metav1alpha1.User{}
+	err = json.Unmarshal(httpWriter.Body.Bytes(), &user)
+	g.Expect(err).To(BeNil())
+	g.Expect(user.Spec.Username).To(Equal("dev"))
 }
 
-func (t *TestProjectCreate) Setup(_ context.Context, _ *zap.SugaredLogger) error {
-	return nil
+func TestArtifactCopy(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ws, err := NewService(&TestArtifactCopier{})
+	g.Expect(err).To(BeNil())
+
+	container := restful.NewContainer()
+	container.Add(ws)
+
+	body := `{"target":{"project":"prod","repository":"app","artifact":"v1"},"overwrite":true}`
+	httpRequest, _ := http.NewRequest("POST", "/plugins/v1alpha1/test-10/projects/staging/repositories/app/artifacts/v1/copy", strings.NewReader(body))
+	httpRequest.Header.Set("Accept", "application/json")
+	httpRequest.Header.Set("Content-Type", "application/json")
+
+	httpWriter := httptest.NewRecorder()
+
+	container.Dispatch(httpWriter, httpRequest)
+	g.Expect(httpWriter.Code).To(Equal(http.StatusOK))
+
+	artifact := metav1alpha1.Artifact{}
+	err = json.Unmarshal(httpWriter.Body.Bytes(), &artifact)
+	g.Expect(err).To(BeNil())
+	g.Expect(artifact.Name).To(Equal("prod/app:v1"))
 }
 
-func (t *TestProjectCreate) CreateProject(ctx context.Context, project *metav1alpha1.Project) (*metav1alpha1.Project, error) {
-	return &metav1alpha1.Project{}, nil
+func TestScanReportGet(t *testing.T) {
+	testCases := map[string]struct {
+		query           string
+		code            int
+		vulnerabilities int
+	}{
+		"summary only":                {query: "", code: http.StatusOK, vulnerabilities: 0},
+		"with vulnerabilities":        {query: "?withVulnerabilities=true", code: http.StatusOK, vulnerabilities: 1},
+		"invalid withVulnerabilities": {query: "?withVulnerabilities=yes", code: http.StatusBadRequest},
+	}
+
+	for name, item := range testCases {
+		test := item
+		t.Run(name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+
+			ws, err := NewService(&TestScanReportGetter{})
+			g.Expect(err).To(BeNil())
+
+			container := restful.NewContainer()
+			container.Add(ws)
+
+			httpRequest, _ := http.NewRequest("GET", "/plugins/v1alpha1/test-11/projects/p/repositories/r/artifacts/v1/scan"+test.query, nil)
+			httpRequest.Header.Set("Accept", "application/json")
+
+			httpWriter := httptest.NewRecorder()
+
+			container.Dispatch(httpWriter, httpRequest)
+			g.Expect(httpWriter.Code).To(Equal(test.code))
+			if test.code != http.StatusOK {
+				return
+			}
+
+			report := metav1alpha1.ScanReport{}
+			err = json.Unmarshal(httpWriter.Body.Bytes(), &report)
+			g.Expect(err).To(BeNil())
+			g.Expect(report.Spec.Status).To(Equal(metav1alpha1.ScanStatusFinished))
+			g.Expect(report.Spec.Summary.Critical).To(Equal(1))
+			g.Expect(report.Spec.Vulnerabilities).To(HaveLen(test.vulnerabilities))
+		})
+	}
 }
 
-func (t *TestProjectCreate) GetProject(ctx context.Context, id string) (*metav1alpha1.Project, error) {
-	return &metav1alpha1.Project{
-		ObjectMeta: metav1.ObjectMeta{
-			Name: id,
-		},
-	}, nil
+func TestArtifactTagList(t *testing.T) {
+	testCases := map[string]struct {
+		path string
+		tag  string
+	}{
+		"repository tags": {path: "/projects/p/repositories/r/tags", tag: "r"},
+		"artifact tags":   {path: "/projects/p/repositories/r/artifacts/sha256:abc/tags", tag: "sha256:abc"},
+	}
+
+	for name, item := range testCases {
+		test := item
+		t.Run(name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+
+			ws, err := NewService(&TestArtifactTagLister{})
+			g.Expect(err).To(BeNil())
+
+			container := restful.NewContainer()
+			container.Add(ws)
+
+			httpRequest, _ := http.NewRequest("GET", "/plugins/v1alpha1/test-12"+test.path, nil)
+			httpRequest.Header.Set("Accept", "application/json")
+
+			httpWriter := httptest.NewRecorder()
+
+			container.Dispatch(httpWriter, httpRequest)
+			g.Expect(httpWriter.Code).To(Equal(http.StatusOK))
+
+			list := metav1alpha1.ArtifactTagList{}
+			err = json.Unmarshal(httpWriter.Body.Bytes(), &list)
+			g.Expect(err).To(BeNil())
+			g.Expect(list.Items).To(HaveLen(1))
+			g.Expect(list.Items[0].Spec.Name).To(Equal(test.tag))
+		})
+	}
 }
 
-type TestResourceList struct {
+func TestArtifactTagListOperations(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ws, err := NewService(&TestArtifactTagLister{})
+	g.Expect(err).To(BeNil())
+
+	docs := map[string]string{}
+	operations := map[string]string{}
+	for _, route := range ws.Routes() {
+		if strings.HasSuffix(route.Path, "/tags") {
+			docs[route.Doc] = route.Path
+			operations[route.Operation] = route.Path
+		}
+	}
+	g.Expect(docs).To(HaveLen(2))
+	g.Expect(operations).To(HaveLen(2))
+	g.Expect(docs).To(HaveKey("ListArtifactTags"))
+	g.Expect(docs).To(HaveKey("ListArtifactTagsByArtifact"))
 }
 
-func (t *TestResourceList) Path() string {
-	return "test-3"
+func TestRepositoryCreate(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ws, err := NewService(&TestRepository{})
+	g.Expect(err).To(BeNil())
+
+	container := restful.NewContainer()
+	container.Add(ws)
+
+	body := `{"name":"app","visibility":"private","defaultBranch":"main"}`
+	httpRequest, _ := http.NewRequest("POST", "/plugins/v1alpha1/test-13/projects/team/repositories", strings.NewReader(body))
+	httpRequest.Header.Set("Accept", "application/json")
+	httpRequest.Header.Set("Content-Type", "application/json")
+
+	httpWriter := httptest.NewRecorder()
+
+	container.Dispatch(httpWriter, httpRequest)
+	g.Expect(httpWriter.Code).To(Equal(http.StatusCreated))
+
+	repository := metav1alpha1.Repository{}
+	err = json.Unmarshal(httpWriter.Body.Bytes(), &repository)
+	g.Expect(err).To(BeNil())
+	g.Expect(repository.Name).To(Equal("team/app"))
+	g.Expect(repository.Spec.Type).To(Equal("private"))
 }
 
-func (t *TestResourceList) Setup(_ context.Context, _ *zap.SugaredLogger) error {
-	return nil
+func TestProjectUpdate(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ws, err := NewService(&TestProjectUpdateDelete{})
+	g.Expect(err).To(BeNil())
+
+	container := restful.NewContainer()
+	container.Add(ws)
+
+	body := `{"spec":{"public":true,"description":"archived"}}`
+	httpRequest, _ := http.NewRequest("PUT", "/plugins/v1alpha1/test-14/projects/team", strings.NewReader(body))
+	httpRequest.Header.Set("Accept", "application/json")
+	httpRequest.Header.Set("Content-Type", "application/json")
+
+	httpWriter := httptest.NewRecorder()
+
+	container.Dispatch(httpWriter, httpRequest)
+	g.Expect(httpWriter.Code).To(Equal(http.StatusOK))
+
+	project := metav1alpha1.Project{}
+	err = json.Unmarshal(httpWriter.Body.Bytes(), &project)
+	g.Expect(err).To(BeNil())
+	g.Expect(project.Name).To(Equal("team"))
+	g.Expect(project.Spec.Public).To(BeTrue())
+	g.Expect(project.Spec.Description).To(Equal("archived"))
 }
 
-func (t *TestResourceList) ListResources(ctx context.Context, option metav1alpha1.ListOptions) (*metav1alpha1.ResourceList, error) {
-	return &metav1alpha1.ResourceList{}, nil
+func TestResourceBrowse(t *testing.T) {
+	testCases := map[string]struct {
+		path  string
+		code  int
+		items []string
+	}{
+		"get resource":         {path: "/resources/ImageRepository?id=team/app", code: http.StatusOK, items: nil},
+		"missing id":           {path: "/resources/ImageRepository", code: http.StatusBadRequest, items: nil},
+		"list children":        {path: "/resources/ImageRegistry/children?id=team", code: http.StatusOK, items: []string{"team/app", "team/web"}},
+		"list nested children": {path: "/resources/ImageRepository/children?id=team/app", code: http.StatusOK, items: []string{"team/app/app", "team/app/web"}},
+		"list children page 2": {path: "/resources/ImageRegistry/children?id=team&page=2&itemsPerPage=1", code: http.StatusOK, items: []string{"team/web"}},
+		"list children page 3": {path: "/resources/ImageRegistry/children?id=team&page=3&itemsPerPage=1", code: http.StatusOK, items: []string{}},
+		"list children no id":  {path: "/resources/ImageRegistry/children", code: http.StatusBadRequest, items: nil},
+	}
+
+	for name, item := range testCases {
+		test := item
+		t.Run(name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+
+			ws, err := NewService(&TestResourceBrowser{})
+			g.Expect(err).To(BeNil())
+
+			container := restful.NewContainer()
+			container.Add(ws)
+
+			httpRequest, _ := http.NewRequest("GET", "/plugins/v1alpha1/test-15"+test.path, nil)
+			httpRequest.Header.Set("Accept", "application/json")
+
+			httpWriter := httptest.NewRecorder()
+
+			container.Dispatch(httpWriter, httpRequest)
+			g.Expect(httpWriter.Code).To(Equal(test.code))
+			if test.code != http.StatusOK {
+				return
+			}
+
+			if test.items == nil {
+				resource := metav1alpha1.Resource{}
+				g.Expect(json.Unmarshal(httpWriter.Body.Bytes(), &resource)).To(Succeed())
+				g.Expect(resource.Name).To(Equal("team/app"))
+				g.Expect(resource.Spec.HasChildren).To(BeTrue())
+				return
+			}
+
+			list := metav1alpha1.ResourceList{}
+			g.Expect(json.Unmarshal(httpWriter.Body.Bytes(), &list)).To(Succeed())
+			names := []string{}
+			for _, resource := range list.Items {
+				g.Expect(resource.Spec.SubType).To(Equal(string(metav1alpha1.ResourceSubTypeImageRepository)))
+				names = append(names, resource.Name)
+			}
+			g.Expect(names).To(Equal(test.items))
+		})
+	}
 }
 
-type TestProjectListCreate struct {
+func TestIssueUpdate(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	impl := &TestIssue{}
+	ws, err := NewService(impl)
+	g.Expect(err).To(BeNil())
+
+	container := restful.NewContainer()
+	container.Add(ws)
+
+	body := `{"state":"closed","labels":[]}`
+	httpRequest, _ := http.NewRequest("PUT", "/plugins/v1alpha1/test-16/projects/katanomi/issues/12?repository=pkg", strings.NewReader(body))
+	httpRequest.Header.Set("Accept", "application/json")
+	httpRequest.Header.Set("Content-Type", "application/json")
+
+	httpWriter := httptest.NewRecorder()
+
+	container.Dispatch(httpWriter, httpRequest)
+	g.Expect(httpWriter.Code).To(Equal(http.StatusOK))
+
+	issue := metav1alpha1.Issue{}
+	err = json.Unmarshal(httpWriter.Body.Bytes(), &issue)
+	g.Expect(err).To(BeNil())
+	g.Expect(issue.Spec.Project).To(Equal("katanomi"))
+	g.Expect(issue.Spec.Repository).To(Equal("pkg"))
+	g.Expect(issue.Spec.Key).To(Equal("12"))
+	g.Expect(issue.Spec.State).To(Equal(metav1alpha1.IssueStateClosed))
+	// empty labels are kept to remove all labels
+	g.Expect(impl.updated.Labels).NotTo(BeNil())
+	g.Expect(impl.updated.Labels).To(BeEmpty())
 }
 
-func (t *TestProjectListCreate) Path() string {
-	return "test-4"
+func TestReleaseAssetUpload(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ws, err := NewService(&TestRelease{})
+	g.Expect(err).To(BeNil())
+
+	container := restful.NewContainer()
+	container.Add(ws)
+
+	// wrap the reader to send the body chunked without content length
+	body := ioutil.NopCloser(strings.NewReader("binary"))
+	httpRequest, _ := http.NewRequest("POST", "/plugins/v1alpha1/test-17/projects/katanomi/coderepositories/pkg/releases/1/assets?name=pkg.tar.gz&size=6", body)
+	httpRequest.Header.Set("Accept", "application/json")
+	httpRequest.Header.Set("Content-Type", "application/gzip")
+	httpRequest.ContentLength = -1
+
+	httpWriter := httptest.NewRecorder()
+
+	container.Dispatch(httpWriter, httpRequest)
+	g.Expect(httpWriter.Code).To(Equal(http.StatusOK))
+
+	asset := metav1alpha1.GitReleaseAsset{}
+	err = json.Unmarshal(httpWriter.Body.Bytes(), &asset)
+	g.Expect(err).To(BeNil())
+	g.Expect(asset.Spec.Name).To(Equal("pkg.tar.gz"))
+	g.Expect(asset.Spec.ContentType).To(Equal("application/gzip"))
+	g.Expect(asset.Spec.Size).To(Equal(int64(6)))
+	g.Expect(asset.Spec.DownloadURL).To(Equal("binary"))
 }
 
-func (t *TestProjectListCreate) Setup(_ context.Context, _ *zap.SugaredLogger) error {
-	return nil
+func TestGitCodeSearch(t *testing.T) {
+	testCases := map[string]struct {
+		query string
+		code  int
+	}{
+		"search with filters": {query: "?query=ioutil&project=katanomi&language=go&page=2&itemsPerPage=10", code: http.StatusOK},
+		"missing query":       {query: "?project=katanomi", code: http.StatusBadRequest},
+	}
+
+	for name, item := range testCases {
+		test := item
+		t.Run(name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+
+			plugin := &TestGitCodeSearcher{}
+			ws, err := NewService(plugin)
+			g.Expect(err).To(BeNil())
+
+			container := restful.NewContainer()
+			container.Add(ws)
+
+			httpRequest, _ := http.NewRequest("GET", "/plugins/v1alpha1/test-18/code/search"+test.query, nil)
+			httpRequest.Header.Set("Accept", "application/json")
+
+			httpWriter := httptest.NewRecorder()
+
+			container.Dispatch(httpWriter, httpRequest)
+			g.Expect(httpWriter.Code).To(Equal(test.code))
+			if test.code != http.StatusOK {
+				return
+			}
+
+			g.Expect(plugin.option).To(Equal(metav1alpha1.GitCodeSearchOption{
+				GitRepo:  metav1alpha1.GitRepo{Project: "katanomi"},
+				Query:    "ioutil",
+				Language: "go",
+			}))
+			g.Expect(plugin.listOption.Page).To(Equal(2))
+			g.Expect(plugin.listOption.ItemsPerPage).To(Equal(10))
+			g.Expect(plugin.listOption.Search).To(BeEmpty())
+
+			list := metav1alpha1.GitCodeSearchResultList{}
+			err = json.Unmarshal(httpWriter.Body.Bytes(), &list)
+			g.Expect(err).To(BeNil())
+			g.Expect(list.Items).To(HaveLen(1))
+			g.Expect(list.Items[0].Spec.Project).To(Equal("katanomi"))
+			g.Expect(list.Items[0].Spec.Fragments[0].Content).To(Equal("ioutil"))
+		})
+	}
 }
 
-func (t *TestProjectListCreate) ListProjects(ctx context.Context, option metav1alpha1.ListOptions) (*metav1alpha1.ProjectList, error) {
-	return &metav1alpha1.ProjectList{}, nil
+func TestGitBranchWithSlash(t *testing.T) {
+	testCases := map[string]struct {
+		method string
+		uri    string
+		body   string
+		code   int
+	}{
+		"delete branch":     {method: "DELETE", uri: "/branches?branch=release/1.0", code: http.StatusOK},
+		"protect branch":    {method: "PUT", uri: "/branches/protection?branch=release/1.0", body: `{"requiredApprovals":2}`, code: http.StatusOK},
+		"unprotect branch":  {method: "DELETE", uri: "/branches/protection?branch=release/1.0", code: http.StatusOK},
+		"missing branch":    {method: "DELETE", uri: "/branches", code: http.StatusBadRequest},
+		"protect no branch": {method: "PUT", uri: "/branches/protection", body: `{}`, code: http.StatusBadRequest},
+	}
+
+	for name, item := range testCases {
+		test := item
+		t.Run(name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+
+			plugin := &TestGitBranch{}
+			ws, err := NewService(plugin)
+			g.Expect(err).To(BeNil())
+
+			container := restful.NewContainer()
+			container.Add(ws)
+
+			httpRequest, _ := http.NewRequest(test.method, "/plugins/v1alpha1/test-20/projects/katanomi/coderepositories/pkg"+test.uri, strings.NewReader(test.body))
+			httpRequest.Header.Set("Accept", "application/json")
+			httpRequest.Header.Set("Content-Type", "application/json")
+
+			httpWriter := httptest.NewRecorder()
+
+			container.Dispatch(httpWriter, httpRequest)
+			g.Expect(httpWriter.Code).To(Equal(test.code))
+			if test.code != http.StatusOK {
+				return
+			}
+
+			g.Expect(plugin.option.Project).To(Equal("katanomi"))
+			g.Expect(plugin.option.Repository).To(Equal("pkg"))
+			g.Expect(plugin.option.Branch).To(Equal("release/1.0"))
+			if test.method == "PUT" {
+				branch := metav1alpha1.GitBranch{}
+				err = json.Unmarshal(httpWriter.Body.Bytes(), &branch)
+				g.Expect(err).To(BeNil())
+				g.Expect(branch.Spec.Name).To(Equal("release/1.0"))
+				g.Expect(*branch.Spec.RequiredApprovals).To(Equal(2))
+			}
+		})
+	}
 }
 
-func (t *TestProjectListCreate) CreateProject(ctx context.Context, project *metav1alpha1.Project) (*metav1alpha1.Project, error) {
-	return &metav1alpha1.Project{}, nil
+func TestGitRepoFileNestedPath(t *testing.T) {
+	testCases := map[string]struct {
+		method string
+		body   string
+	}{
+		"create file": {method: "POST", body: `{"branch":"main","message":"create","content":"YQ=="}`},
+		"update file": {method: "PUT", body: `{"branch":"main","message":"update","content":"YQ==","sha":"abc"}`},
+		"delete file": {method: "DELETE", body: `{"branch":"main","message":"delete","sha":"abc"}`},
+	}
+
+	for name, item := range testCases {
+		test := item
+		t.Run(name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+
+			ws, err := NewService(&TestGitRepoFile{})
+			g.Expect(err).To(BeNil())
+
+			container := restful.NewContainer()
+			container.Add(ws)
+
+			httpRequest, _ := http.NewRequest(test.method, "/plugins/v1alpha1/test-21/projects/katanomi/coderepositories/pkg/content/config/app.yaml", strings.NewReader(test.body))
+			httpRequest.Header.Set("Accept", "application/json")
+			httpRequest.Header.Set("Content-Type", "application/json")
+
+			httpWriter := httptest.NewRecorder()
+
+			container.Dispatch(httpWriter, httpRequest)
+			g.Expect(httpWriter.Code).To(Equal(http.StatusOK))
+
+			commit := metav1alpha1.GitCommit{}
+			err = json.Unmarshal(httpWriter.Body.Bytes(), &commit)
+			g.Expect(err).To(BeNil())
+			g.Expect(*commit.Spec.Message).To(Equal("config/app.yaml"))
+		})
+	}
+}
+
+func TestGitPullRequestNoteRoutes(t *testing.T) {
+	testCases := map[string]struct {
+		method string
+		uri    string
+		body   string
+		code   int
+		check  func(g *GomegaWithT, plugin *TestGitPullRequestNote, body []byte)
+	}{
+		"create inline note": {
+			method: "POST",
+			uri:    "/pulls/1/note",
+			body:   `{"body":"nit","position":{"path":"main.go","line":10,"oldLine":8,"commitSHA":"abc"}}`,
+			code:   http.StatusOK,
+			check: func(g *GomegaWithT, plugin *TestGitPullRequestNote, body []byte) {
+				note := metav1alpha1.GitPullRequestNote{}
+				g.Expect(json.Unmarshal(body, &note)).To(Succeed())
+				g.Expect(note.Spec.Body).To(Equal("nit"))
+				g.Expect(note.Spec.Position).To(Equal(&metav1alpha1.GitPullRequestNotePosition{
+					Path: "main.go", Line: 10, OldLine: 8, CommitSHA: "abc",
+				}))
+			},
+		},
+		"list notes page 2": {
+			method: "GET",
+			uri:    "/pulls/1/note?page=2&itemsPerPage=1",
+			code:   http.StatusOK,
+			check: func(g *GomegaWithT, plugin *TestGitPullRequestNote, body []byte) {
+				list := metav1alpha1.GitPullRequestNoteList{}
+				g.Expect(json.Unmarshal(body, &list)).To(Succeed())
+				g.Expect(list.TotalItems).To(Equal(3))
+				g.Expect(list.Items).To(HaveLen(1))
+				g.Expect(list.Items[0].Spec.ID).To(Equal(2))
+			},
+		},
+		"update note": {
+			method: "PUT",
+			uri:    "/pulls/1/note/2",
+			body:   `{"body":"updated"}`,
+			code:   http.StatusOK,
+			check: func(g *GomegaWithT, plugin *TestGitPullRequestNote, body []byte) {
+				note := metav1alpha1.GitPullRequestNote{}
+				g.Expect(json.Unmarshal(body, &note)).To(Succeed())
+				g.Expect(note.Spec.ID).To(Equal(2))
+				g.Expect(note.Spec.Body).To(Equal("updated"))
+			},
+		},
+		"delete note": {
+			method: "DELETE",
+			uri:    "/pulls/1/note/2",
+			code:   http.StatusOK,
+			check: func(g *GomegaWithT, plugin *TestGitPullRequestNote, body []byte) {
+				g.Expect(plugin.deleted.Index).To(Equal(1))
+				g.Expect(plugin.deleted.CommentID).To(Equal(2))
+			},
+		},
+	}
+
+	for name, item := range testCases {
+		test := item
+		t.Run(name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+
+			plugin := &TestGitPullRequestNote{}
+			ws, err := NewService(plugin)
+			g.Expect(err).To(BeNil())
+
+			container := restful.NewContainer()
+			container.Add(ws)
+
+			httpRequest, _ := http.NewRequest(test.method, "/plugins/v1alpha1/test-22/projects/katanomi/coderepositories/pkg"+test.uri, strings.NewReader(test.body))
+			httpRequest.Header.Set("Accept", "application/json")
+			httpRequest.Header.Set("Content-Type", "application/json")
+
+			httpWriter := httptest.NewRecorder()
+
+			container.Dispatch(httpWriter, httpRequest)
+			g.Expect(httpWriter.Code).To(Equal(test.code))
+			test.check(g, plugin, httpWriter.Body.Bytes())
+		})
+	}
+}
+
+func TestGitCommitList(t *testing.T) {
+	testCases := map[string]struct {
+		query string
+		code  int
+	}{
+		"list with filters": {query: "?ref=main&path=go.mod&author=dev&since=2021-10-01T00:00:00Z&until=2021-10-31T00:00:00Z", code: http.StatusOK},
+		"invalid since":     {query: "?since=yesterday", code: http.StatusBadRequest},
+		"invalid until":     {query: "?until=2021-10-31", code: http.StatusBadRequest},
+	}
+
+	for name, item := range testCases {
+		test := item
+		t.Run(name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+
+			plugin := &TestGitCommit{}
+			ws, err := NewService(plugin)
+			g.Expect(err).To(BeNil())
+
+			container := restful.NewContainer()
+			container.Add(ws)
+
+			httpRequest, _ := http.NewRequest("GET", "/plugins/v1alpha1/test-23/projects/katanomi/coderepositories/pkg/commits"+test.query, nil)
+			httpRequest.Header.Set("Accept", "application/json")
+
+			httpWriter := httptest.NewRecorder()
+
+			container.Dispatch(httpWriter, httpRequest)
+			g.Expect(httpWriter.Code).To(Equal(test.code))
+			if test.code != http.StatusOK {
+				return
+			}
+
+			g.Expect(plugin.option.GitRepo).To(Equal(metav1alpha1.GitRepo{Project: "katanomi", Repository: "pkg"}))
+			g.Expect(plugin.option.Ref).To(Equal("main"))
+			g.Expect(plugin.option.Path).To(Equal("go.mod"))
+			g.Expect(plugin.option.Author).To(Equal("dev"))
+			g.Expect(plugin.option.Since.Format(time.RFC3339)).To(Equal("2021-10-01T00:00:00Z"))
+			g.Expect(plugin.option.Until.Format(time.RFC3339)).To(Equal("2021-10-31T00:00:00Z"))
+		})
+	}
+}
+
+func TestGitPullRequestStateChange(t *testing.T) {
+	testCases := map[string]struct {
+		uri    string
+		body   string
+		action string
+	}{
+		"merge pr":  {uri: "/pulls/3/merge", body: `{"method":"squash","commitMessage":"release"}`, action: "merge"},
+		"close pr":  {uri: "/pulls/3/close", action: "close"},
+		"reopen pr": {uri: "/pulls/3/reopen", action: "reopen"},
+		"update pr": {uri: "/pulls/3", body: `{"title":"new title"}`, action: "update"},
+	}
+
+	for name, item := range testCases {
+		test := item
+		t.Run(name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+
+			plugin := &TestGitPullRequestLifecycle{}
+			ws, err := NewService(plugin)
+			g.Expect(err).To(BeNil())
+
+			container := restful.NewContainer()
+			container.Add(ws)
+
+			httpRequest, _ := http.NewRequest("PUT", "/plugins/v1alpha1/test-24/projects/katanomi/coderepositories/pkg"+test.uri, strings.NewReader(test.body))
+			httpRequest.Header.Set("Accept", "application/json")
+			httpRequest.Header.Set("Content-Type", "application/json")
+
+			httpWriter := httptest.NewRecorder()
+
+			container.Dispatch(httpWriter, httpRequest)
+			g.Expect(httpWriter.Code).To(Equal(http.StatusOK))
+			g.Expect(plugin.action).To(Equal(test.action))
+			g.Expect(plugin.option.Index).To(Equal(3))
+			g.Expect(plugin.option.GitRepo).To(Equal(metav1alpha1.GitRepo{Project: "katanomi", Repository: "pkg"}))
+			switch test.action {
+			case "merge":
+				g.Expect(plugin.merge.Method).To(Equal(metav1alpha1.GitPullRequestMergeMethodSquash))
+				g.Expect(plugin.merge.CommitMessage).To(Equal("release"))
+			case "update":
+				g.Expect(*plugin.update.Title).To(Equal("new title"))
+				g.Expect(plugin.update.Description).To(BeNil())
+			}
+		})
+	}
+}
+
+func TestGitCommitStatus(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	plugin := &TestGitCommitStatusHandler{}
+	ws, err := NewService(plugin)
+	g.Expect(err).To(BeNil())
+
+	container := restful.NewContainer()
+	container.Add(ws)
+
+	body := `{"state":"success","context":"ci/build","targetURL":"https://ci.example.com/1","description":"passed","coverage":87.5}`
+	httpRequest, _ := http.NewRequest("POST", "/plugins/v1alpha1/test-25/projects/katanomi/coderepositories/pkg/commit/abc/statuses", strings.NewReader(body))
+	httpRequest.Header.Set("Accept", "application/json")
+	httpRequest.Header.Set("Content-Type", "application/json")
+
+	httpWriter := httptest.NewRecorder()
+
+	container.Dispatch(httpWriter, httpRequest)
+	g.Expect(httpWriter.Code).To(Equal(http.StatusOK))
+
+	status := metav1alpha1.GitCommitStatus{}
+	err = json.Unmarshal(httpWriter.Body.Bytes(), &status)
+	g.Expect(err).To(BeNil())
+	g.Expect(*status.Spec.SHA).To(Equal("abc"))
+	g.Expect(status.Spec.State).To(Equal(metav1alpha1.GitCommitStatusStateSuccess))
+	g.Expect(status.Spec.Context).To(Equal("ci/build"))
+	g.Expect(status.Spec.TargetURL).To(Equal("https://ci.example.com/1"))
+	g.Expect(status.Spec.Description).To(Equal("passed"))
+	g.Expect(*status.Spec.Coverage).To(Equal(87.5))
+	g.Expect(plugin.payload.GitRepo).To(Equal(metav1alpha1.GitRepo{Project: "katanomi", Repository: "pkg"}))
+
+	httpRequest, _ = http.NewRequest("GET", "/plugins/v1alpha1/test-25/projects/katanomi/coderepositories/pkg/commit/abc/statuses", nil)
+	httpRequest.Header.Set("Accept", "application/json")
+
+	httpWriter = httptest.NewRecorder()
+
+	container.Dispatch(httpWriter, httpRequest)
+	g.Expect(httpWriter.Code).To(Equal(http.StatusOK))
+
+	list := metav1alpha1.GitCommitStatusList{}
+	err = json.Unmarshal(httpWriter.Body.Bytes(), &list)
+	g.Expect(err).To(BeNil())
+	g.Expect(list.Items).To(HaveLen(1))
+	g.Expect(list.Items[0].Spec.Context).To(Equal("ci/build"))
+}
+
+func TestGitCommitStatusInvalidState(t *testing.T) {
+	testCases := map[string]string{
+		"unknown state": `{"state":"running","context":"ci/build"}`,
+		"empty state":   `{"context":"ci/build"}`,
+	}
+
+	for name, item := range testCases {
+		body := item
+		t.Run(name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+
+			plugin := &TestGitCommitStatusHandler{}
+			ws, err := NewService(plugin)
+			g.Expect(err).To(BeNil())
+
+			container := restful.NewContainer()
+			container.Add(ws)
+
+			httpRequest, _ := http.NewRequest("POST", "/plugins/v1alpha1/test-25/projects/katanomi/coderepositories/pkg/commit/abc/statuses", strings.NewReader(body))
+			httpRequest.Header.Set("Accept", "application/json")
+			httpRequest.Header.Set("Content-Type", "application/json")
+
+			httpWriter := httptest.NewRecorder()
+
+			container.Dispatch(httpWriter, httpRequest)
+			g.Expect(httpWriter.Code).To(Equal(http.StatusBadRequest))
+			g.Expect(plugin.payload.State).To(BeEmpty())
+		})
+	}
+}
+
+func TestGitRepoTreeList(t *testing.T) {
+	testCases := map[string]struct {
+		query     string
+		code      int
+		recursive bool
+	}{
+		"list root":         {query: "", code: http.StatusOK, recursive: false},
+		"list recursively":  {query: "?path=plugin&ref=main&recursive=true", code: http.StatusOK, recursive: true},
+		"invalid recursive": {query: "?recursive=yes", code: http.StatusBadRequest},
+	}
+
+	for name, item := range testCases {
+		test := item
+		t.Run(name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+
+			plugin := &TestGitRepoTree{}
+			ws, err := NewService(plugin)
+			g.Expect(err).To(BeNil())
+
+			container := restful.NewContainer()
+			container.Add(ws)
+
+			httpRequest, _ := http.NewRequest("GET", "/plugins/v1alpha1/test-26/projects/katanomi/coderepositories/pkg/tree"+test.query, nil)
+			httpRequest.Header.Set("Accept", "application/json")
+
+			httpWriter := httptest.NewRecorder()
+
+			container.Dispatch(httpWriter, httpRequest)
+			g.Expect(httpWriter.Code).To(Equal(test.code))
+			if test.code != http.StatusOK {
+				return
+			}
+
+			g.Expect(plugin.option.GitRepo).To(Equal(metav1alpha1.GitRepo{Project: "katanomi", Repository: "pkg"}))
+			g.Expect(plugin.option.Recursive).To(Equal(test.recursive))
+
+			list := metav1alpha1.GitRepoTreeEntryList{}
+			err = json.Unmarshal(httpWriter.Body.Bytes(), &list)
+			g.Expect(err).To(BeNil())
+			g.Expect(list.Items).To(HaveLen(1))
+			g.Expect(list.Items[0].Spec.Path).To(Equal(plugin.option.Path + "/go.mod"))
+		})
+	}
+}
+
+func TestGitCompareGet(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ws, err := NewService(&TestGitCompare{})
+	g.Expect(err).To(BeNil())
+
+	container := restful.NewContainer()
+	container.Add(ws)
+
+	httpRequest, _ := http.NewRequest("GET", "/plugins/v1alpha1/test-27/projects/katanomi/coderepositories/pkg/compare?base=release/1.0&head=main", nil)
+	httpRequest.Header.Set("Accept", "application/json")
+
+	httpWriter := httptest.NewRecorder()
+
+	container.Dispatch(httpWriter, httpRequest)
+	g.Expect(httpWriter.Code).To(Equal(http.StatusOK))
+
+	compare := metav1alpha1.GitCompare{}
+	err = json.Unmarshal(httpWriter.Body.Bytes(), &compare)
+	g.Expect(err).To(BeNil())
+	g.Expect(compare.Spec.GitRepo).To(Equal(metav1alpha1.GitRepo{Project: "katanomi", Repository: "pkg"}))
+	g.Expect(compare.Spec.Base).To(Equal("release/1.0"))
+	g.Expect(compare.Spec.Head).To(Equal("main"))
+	g.Expect(compare.Spec.Files).To(HaveLen(1))
+	g.Expect(compare.Spec.Files[0].Status).To(Equal(metav1alpha1.GitChangedFileStatusModified))
+}
+
+func TestGitCompareMissingRefs(t *testing.T) {
+	testCases := map[string]string{
+		"missing base": "?head=main",
+		"missing head": "?base=release/1.0",
+		"empty refs":   "?base=&head=",
+	}
+
+	for name, item := range testCases {
+		query := item
+		t.Run(name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+
+			ws, err := NewService(&TestGitCompare{})
+			g.Expect(err).To(BeNil())
+
+			container := restful.NewContainer()
+			container.Add(ws)
+
+			httpRequest, _ := http.NewRequest("GET", "/plugins/v1alpha1/test-27/projects/katanomi/coderepositories/pkg/compare"+query, nil)
+			httpRequest.Header.Set("Accept", "application/json")
+
+			httpWriter := httptest.NewRecorder()
+
+			container.Dispatch(httpWriter, httpRequest)
+			g.Expect(httpWriter.Code).To(Equal(http.StatusBadRequest))
+		})
+	}
+}
+
+func TestGitPullRequestChanges(t *testing.T) {
+	testCases := map[string]struct {
+		uri   string
+		check func(g *GomegaWithT, body []byte)
+	}{
+		"list pr commits": {
+			uri: "/pulls/5/commits?page=1&itemsPerPage=10",
+			check: func(g *GomegaWithT, body []byte) {
+				list := metav1alpha1.GitCommitList{}
+				g.Expect(json.Unmarshal(body, &list)).To(Succeed())
+				g.Expect(list.Items).To(HaveLen(1))
+				g.Expect(*list.Items[0].Spec.SHA).To(Equal("5"))
+			},
+		},
+		"list pr files": {
+			uri: "/pulls/5/files?page=1&itemsPerPage=10",
+			check: func(g *GomegaWithT, body []byte) {
+				list := metav1alpha1.GitChangedFileList{}
+				g.Expect(json.Unmarshal(body, &list)).To(Succeed())
+				g.Expect(list.Items).To(HaveLen(1))
+				g.Expect(list.Items[0].Path).To(Equal("go.mod"))
+				g.Expect(*list.Items[0].Patch).To(Equal("@@ -1 +1 @@"))
+			},
+		},
+	}
+
+	for name, item := range testCases {
+		test := item
+		t.Run(name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+
+			plugin := &TestGitPullRequestChangeLister{}
+			ws, err := NewService(plugin)
+			g.Expect(err).To(BeNil())
+
+			container := restful.NewContainer()
+			container.Add(ws)
+
+			httpRequest, _ := http.NewRequest("GET", "/plugins/v1alpha1/test-28/projects/katanomi/coderepositories/pkg"+test.uri, nil)
+			httpRequest.Header.Set("Accept", "application/json")
+
+			httpWriter := httptest.NewRecorder()
+
+			container.Dispatch(httpWriter, httpRequest)
+			g.Expect(httpWriter.Code).To(Equal(http.StatusOK))
+			g.Expect(plugin.listOption.ItemsPerPage).To(Equal(10))
+			test.check(g, httpWriter.Body.Bytes())
+		})
+	}
+}
+
+func TestGitTagCreate(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ws, err := NewService(&TestGitTag{}, client.MetaFilter)
+	g.Expect(err).To(BeNil())
+
+	container := restful.NewContainer()
+
+	container.Add(ws)
+
+	body := strings.NewReader(`{"tag":"v1.0.0","ref":"main"}`)
+	httpRequest, _ := http.NewRequest("POST", "/plugins/v1alpha1/test-5/projects/katanomi/coderepositories/pkg/tags", body)
+	httpRequest.Header.Set("Accept", "application/json")
+	httpRequest.Header.Set("Content-Type", "application/json")
+
+	httpWriter := httptest.NewRecorder()
+
+	container.Dispatch(httpWriter, httpRequest)
+	g.Expect(httpWriter.Code).To(Equal(http.StatusOK))
+
+	tag := metav1alpha1.GitTag{}
+	err = json.Unmarshal(httpWriter.Body.Bytes(), &tag)
+	g.Expect(err).To(BeNil())
+	g.Expect(tag.Spec.Name).To(Equal("v1.0.0"))
+	g.Expect(tag.Spec.Project).To(Equal("katanomi"))
+	g.Expect(tag.Spec.Repository).To(Equal("pkg"))
+	g.Expect(*tag.Spec.Commit.SHA).To(Equal("main"))
+}
+
+func TestWebhookCreate(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ws, err := NewService(&TestWebhook{}, client.AuthFilter)
+	g.Expect(err).To(BeNil())
+
+	container := restful.NewContainer()
+
+	container.Add(ws)
+
+	body := strings.NewReader(`{"uri":"https://github.com/katanomi/pkg","events":["push"]}`)
+	httpRequest, _ := http.NewRequest("POST", "/plugins/v1alpha1/test-6/webhooks", body)
+	httpRequest.Header.Set("Accept", "application/json")
+	httpRequest.Header.Set("Content-Type", "application/json")
+
+	secretData, _ := json.Marshal(map[string][]byte{"username": []byte("admin")})
+	httpRequest.Header.Set(client.PluginAuthHeader, string(metav1alpha1.AuthTypeBasic))
+	httpRequest.Header.Set(client.PluginSecretHeader, base64.StdEncoding.EncodeToString(secretData))
+
+	httpWriter := httptest.NewRecorder()
+
+	container.Dispatch(httpWriter, httpRequest)
+	g.Expect(httpWriter.Code).To(Equal(http.StatusOK))
+
+	status := metav1alpha1.WebhookRegisterStatus{}
+	err = json.Unmarshal(httpWriter.Body.Bytes(), &status)
+	g.Expect(err).To(BeNil())
+	g.Expect(status.WebhookID).To(Equal("admin"))
+	g.Expect(string(status.Body)).To(Equal("https://github.com/katanomi/pkg"))
+}
+
+type TestProjectList struct {
+}
+
+func (t *TestProjectList) Path() string {
+	return "test-1"
+}
+
+func (t *TestProjectList) Setup(_ context.Context, _ *zap.SugaredLogger) error {
+	return nil
+}
+
+func (t *TestProjectList) ListProjects(ctx context.Context, option metav1alpha1.ListOptions) (*metav1alpha1.ProjectList, error) {
+	return &metav1alpha1.ProjectList{
+		Items: []metav1alpha1.Project{
+			{
+				TypeMeta: metav1.TypeMeta{
+					Kind: "1",
+				},
+			},
+			{
+				TypeMeta: metav1.TypeMeta{
+					Kind: "2",
+				},
+			},
+		},
+	}, nil
+}
+
+type TestProjectCreate struct {
+}
+
+func (t *TestProjectCreate) Path() string {
+	return "test-2"
+}
+
+func (t *TestProjectCreate) Setup(_ context.Context, _ *zap.SugaredLogger) error {
+	return nil
+}
+
+func (t *TestProjectCreate) CreateProject(ctx context.Context, project *metav1alpha1.Project) (*metav1alpha1.Project, error) {
+	return &metav1alpha1.Project{}, nil
+}
+
+func (t *TestProjectCreate) GetProject(ctx context.Context, id string) (*metav1alpha1.Project, error) {
+	return &metav1alpha1.Project{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: id,
+		},
+	}, nil
+}
+
+type TestResourceList struct {
+}
+
+func (t *TestResourceList) Path() string {
+	return "test-3"
+}
+
+func (t *TestResourceList) Setup(_ context.Context, _ *zap.SugaredLogger) error {
+	return nil
+}
+
+func (t *TestResourceList) ListResources(ctx context.Context, option metav1alpha1.ListOptions) (*metav1alpha1.ResourceList, error) {
+	return &metav1alpha1.ResourceList{}, nil
+}
+
+type TestProjectListCreate struct {
+}
+
+func (t *TestProjectListCreate) Path() string {
+	return "test-4"
+}
+
+func (t *TestProjectListCreate) Setup(_ context.Context, _ *zap.SugaredLogger) error {
+	return nil
+}
+
+func (t *TestProjectListCreate) ListProjects(ctx context.Context, option metav1alpha1.ListOptions) (*metav1alpha1.ProjectList, error) {
+	return &metav1alpha1.ProjectList{}, nil
+}
+
+func (t *TestProjectListCreate) CreateProject(ctx context.Context, project *metav1alpha1.Project) (*metav1alpha1.Project, error) {
+	return &metav1alpha1.Project{}, nil
+}
+
+type TestGitTag struct {
+}
+
+func (t *TestGitTag) Path() string {
+	return "test-5"
+}
+
+func (t *TestGitTag) Setup(_ context.Context, _ *zap.SugaredLogger) error {
+	return nil
+}
+
+func (t *TestGitTag) ListGitTag(ctx context.Context, repoOption metav1alpha1.GitRepo, option metav1alpha1.ListOptions) (metav1alpha1.GitTagList, error) {
+	return metav1alpha1.GitTagList{}, nil
+}
+
+func (t *TestGitTag) CreateGitTag(ctx context.Context, payload metav1alpha1.CreateTagPayload) (metav1alpha1.GitTag, error) {
+	return metav1alpha1.GitTag{
+		Spec: metav1alpha1.GitTagSpec{
+			GitRepo: payload.GitRepo,
+			Name:    payload.Tag,
+			Commit:  metav1alpha1.GitCommitBasicInfo{SHA: &payload.Ref},
+		},
+	}, nil
+}
+
+type TestWebhook struct {
+}
+
+func (t *TestWebhook) Path() string {
+	return "test-6"
+}
+
+func (t *TestWebhook) Setup(_ context.Context, _ *zap.SugaredLogger) error {
+	return nil
+}
+
+func (t *TestWebhook) CreateWebhook(ctx context.Context, spec metav1alpha1.WebhookRegisterSpec, secret corev1.Secret) (metav1alpha1.WebhookRegisterStatus, error) {
+	return metav1alpha1.WebhookRegisterStatus{WebhookID: string(secret.Data["username"]), Body: []byte(spec.URI.String())}, nil
+}
+
+func (t *TestWebhook) UpdateWebhook(ctx context.Context, spec metav1alpha1.WebhookRegisterSpec, secret corev1.Secret) (metav1alpha1.WebhookRegisterStatus, error) {
+	return metav1alpha1.WebhookRegisterStatus{}, nil
+}
+
+func (t *TestWebhook) DeleteWebhook(ctx context.Context, spec metav1alpha1.WebhookRegisterSpec, secret corev1.Secret) error {
+	return nil
+}
+
+type TestProjectMember struct {
+	removed rbacv1.Subject
+}
+
+func (t *TestProjectMember) Path() string {
+	return "test-8"
+}
+
+func (t *TestProjectMember) Setup(_ context.Context, _ *zap.SugaredLogger) error {
+	return nil
+}
+
+func (t *TestProjectMember) ListProjectMembers(ctx context.Context, id string, option metav1alpha1.ListOptions) (*metav1alpha1.ProjectMemberList, error) {
+	return &metav1alpha1.ProjectMemberList{}, nil
+}
+
+func (t *TestProjectMember) AddProjectMember(ctx context.Context, id string, member *metav1alpha1.ProjectMember) (*metav1alpha1.ProjectMember, error) {
+	return member, nil
+}
+
+func (t *TestProjectMember) RemoveProjectMember(ctx context.Context, id string, subject rbacv1.Subject) error {
+	t.removed = subject
+	return nil
+}
+
+type TestUser struct {
+}
+
+func (t *TestUser) Path() string {
+	return "test-9"
+}
+
+func (t *TestUser) Setup(_ context.Context, _ *zap.SugaredLogger) error {
+	return nil
+}
+
+func (t *TestUser) GetCurrentUser(ctx context.Context) (*metav1alpha1.User, error) {
+	username, _, err := client.ExtractAuth(ctx).GetBasicInfo()
+	if err != nil {
+		return nil, err
+	}
+	return &metav1alpha1.User{Spec: metav1alpha1.UserSpec{Username: username}}, nil
+}
+
+func (t *TestUser) ListUsers(ctx context.Context, option metav1alpha1.ListOptions) (*metav1alpha1.UserList, error) {
+	return &metav1alpha1.UserList{}, nil
+}
+
+type TestArtifactCopier struct {
+}
+
+func (t *TestArtifactCopier) Path() string {
+	return "test-10"
+}
+
+func (t *TestArtifactCopier) Setup(_ context.Context, _ *zap.SugaredLogger) error {
+	return nil
+}
+
+func (t *TestArtifactCopier) CopyArtifact(ctx context.Context, params metav1alpha1.ArtifactOptions, option metav1alpha1.ArtifactCopyOptions) (*metav1alpha1.Artifact, error) {
+	if !option.Overwrite || params.Project != "staging" {
+		return nil, fmt.Errorf("unexpected copy options")
+	}
+	target := option.Target
+	return &metav1alpha1.Artifact{
+		ObjectMeta: metav1.ObjectMeta{Name: target.Project + "/" + target.Repository + ":" + target.Artifact},
+	}, nil
+}
+
+type TestScanReportGetter struct {
+}
+
+func (t *TestScanReportGetter) Path() string {
+	return "test-11"
+}
+
+func (t *TestScanReportGetter) Setup(_ context.Context, _ *zap.SugaredLogger) error {
+	return nil
+}
+
+func (t *TestScanReportGetter) GetScanReport(ctx context.Context, params metav1alpha1.ArtifactOptions, option metav1alpha1.ScanReportOptions) (*metav1alpha1.ScanReport, error) {
+	report := &metav1alpha1.ScanReport{
+		Spec: metav1alpha1.ScanReportSpec{
+			Status:  metav1alpha1.ScanStatusFinished,
+			Summary: &metav1alpha1.VulnerabilitySummary{Total: 1, Critical: 1},
+		},
+	}
+	if option.WithVulnerabilities {
+		report.Spec.Vulnerabilities = []metav1alpha1.Vulnerability{
+			{ID: "CVE-2021-44228", Severity: metav1alpha1.VulnerabilitySeverityCritical},
+		}
+	}
+	return report, nil
+}
+
+type TestArtifactTagLister struct {
+}
+
+func (t *TestArtifactTagLister) Path() string {
+	return "test-12"
+}
+
+func (t *TestArtifactTagLister) Setup(_ context.Context, _ *zap.SugaredLogger) error {
+	return nil
+}
+
+func (t *TestArtifactTagLister) ListArtifactTags(ctx context.Context, params metav1alpha1.ArtifactOptions, option metav1alpha1.ListOptions) (*metav1alpha1.ArtifactTagList, error) {
+	name := params.Repository
+	if params.Artifact != "" {
+		name = params.Artifact
+	}
+	return &metav1alpha1.ArtifactTagList{
+		Items: []metav1alpha1.ArtifactTag{{Spec: metav1alpha1.ArtifactTagSpec{Name: name}}},
+	}, nil
+}
+
+type TestRepository struct {
+}
+
+func (t *TestRepository) Path() string {
+	return "test-13"
+}
+
+func (t *TestRepository) Setup(_ context.Context, _ *zap.SugaredLogger) error {
+	return nil
+}
+
+func (t *TestRepository) CreateRepository(ctx context.Context, params metav1alpha1.RepositoryOptions, option metav1alpha1.RepositoryCreateOptions) (*metav1alpha1.Repository, error) {
+	return &metav1alpha1.Repository{
+		ObjectMeta: metav1.ObjectMeta{Name: params.Project + "/" + option.Name},
+		Spec:       metav1alpha1.RepositorySpec{Type: string(option.Visibility)},
+	}, nil
+}
+
+func (t *TestRepository) DeleteRepository(ctx context.Context, params metav1alpha1.RepositoryOptions, repository string) error {
+	return nil
+}
+
+type TestProjectUpdateDelete struct {
+}
+
+func (t *TestProjectUpdateDelete) Path() string {
+	return "test-14"
+}
+
+func (t *TestProjectUpdateDelete) Setup(_ context.Context, _ *zap.SugaredLogger) error {
+	return nil
+}
+
+func (t *TestProjectUpdateDelete) UpdateProject(ctx context.Context, project *metav1alpha1.Project) (*metav1alpha1.Project, error) {
+	return project, nil
+}
+
+func (t *TestProjectUpdateDelete) DeleteProject(ctx context.Context, id string) error {
+	return nil
+}
+
+type TestResourceBrowser struct {
+}
+
+func (t *TestResourceBrowser) Path() string {
+	return "test-15"
+}
+
+func (t *TestResourceBrowser) Setup(_ context.Context, _ *zap.SugaredLogger) error {
+	return nil
+}
+
+func (t *TestResourceBrowser) GetResource(ctx context.Context, params metav1alpha1.ResourceOptions) (*metav1alpha1.Resource, error) {
+	return &metav1alpha1.Resource{
+		ObjectMeta: metav1.ObjectMeta{Name: params.ID},
+		Spec:       metav1alpha1.ResourceSpec{SubType: string(params.SubType), HasChildren: true},
+	}, nil
+}
+
+func (t *TestResourceBrowser) ListResourceChildren(ctx context.Context, params metav1alpha1.ResourceOptions, option metav1alpha1.ListOptions) (*metav1alpha1.ResourceList, error) {
+	children := []metav1alpha1.Resource{}
+	for _, name := range []string{"app", "web"} {
+		children = append(children, metav1alpha1.Resource{
+			ObjectMeta: metav1.ObjectMeta{Name: params.ID + "/" + name},
+			Spec:       metav1alpha1.ResourceSpec{SubType: string(metav1alpha1.ResourceSubTypeImageRepository)},
+		})
+	}
+	if option.ItemsPerPage > 0 && option.Page > 0 {
+		start := (option.Page - 1) * option.ItemsPerPage
+		if start > len(children) {
+			start = len(children)
+		}
+		end := start + option.ItemsPerPage
+		if end > len(children) {
+			end = len(children)
+		}
+		children = children[start:end]
+	}
+	return &metav1alpha1.ResourceList{Items: children}, nil
+}
+
+type TestIssue struct {
+	updated metav1alpha1.UpdateIssueParams
+}
+
+func (t *TestIssue) Path() string {
+	return "test-16"
+}
+
+func (t *TestIssue) Setup(_ context.Context, _ *zap.SugaredLogger) error {
+	return nil
+}
+
+func (t *TestIssue) ListIssues(ctx context.Context, params metav1alpha1.IssueOptions, option metav1alpha1.ListOptions) (*metav1alpha1.IssueList, error) {
+	return &metav1alpha1.IssueList{}, nil
+}
+
+func (t *TestIssue) GetIssue(ctx context.Context, params metav1alpha1.IssueOptions) (*metav1alpha1.Issue, error) {
+	return &metav1alpha1.Issue{}, nil
+}
+
+func (t *TestIssue) CreateIssue(ctx context.Context, params metav1alpha1.IssueOptions, payload metav1alpha1.CreateIssueParams) (*metav1alpha1.Issue, error) {
+	return &metav1alpha1.Issue{}, nil
+}
+
+func (t *TestIssue) UpdateIssue(ctx context.Context, params metav1alpha1.IssueOptions, payload metav1alpha1.UpdateIssueParams) (*metav1alpha1.Issue, error) {
+	t.updated = payload
+	issue := &metav1alpha1.Issue{
+		Spec: metav1alpha1.IssueSpec{
+			Project:    params.Project,
+			Repository: params.Repository,
+			Key:        params.Issue,
+		},
+	}
+	if payload.State != nil {
+		issue.Spec.State = *payload.State
+	}
+	return issue, nil
+}
+
+func (t *TestIssue) CreateIssueComment(ctx context.Context, params metav1alpha1.IssueOptions, payload metav1alpha1.CreateIssueCommentParams) (*metav1alpha1.IssueComment, error) {
+	return &metav1alpha1.IssueComment{}, nil
+}
+
+type TestRelease struct {
+}
+
+func (t *TestRelease) Path() string {
+	return "test-17"
+}
+
+func (t *TestRelease) Setup(_ context.Context, _ *zap.SugaredLogger) error {
+	return nil
+}
+
+func (t *TestRelease) ListReleases(ctx context.Context, repoOption metav1alpha1.GitRepo, option metav1alpha1.ListOptions) (metav1alpha1.GitReleaseList, error) {
+	return metav1alpha1.GitReleaseList{}, nil
+}
+
+func (t *TestRelease) CreateRelease(ctx context.Context, payload metav1alpha1.CreateReleasePayload) (metav1alpha1.GitRelease, error) {
+	return metav1alpha1.GitRelease{}, nil
+}
+
+func (t *TestRelease) UploadReleaseAsset(ctx context.Context, option metav1alpha1.GitReleaseAssetOption, content io.Reader) (metav1alpha1.GitReleaseAsset, error) {
+	data, err := ioutil.ReadAll(content)
+	if err != nil {
+		return metav1alpha1.GitReleaseAsset{}, err
+	}
+	return metav1alpha1.GitReleaseAsset{
+		Spec: metav1alpha1.GitReleaseAssetSpec{
+			Name:        option.Name,
+			ContentType: option.ContentType,
+			Size:        option.Size,
+			DownloadURL: string(data),
+		},
+	}, nil
+}
+
+type TestGitCodeSearcher struct {
+	option     metav1alpha1.GitCodeSearchOption
+	listOption metav1alpha1.ListOptions
+}
+
+func (t *TestGitCodeSearcher) Path() string {
+	return "test-18"
+}
+
+func (t *TestGitCodeSearcher) Setup(_ context.Context, _ *zap.SugaredLogger) error {
+	return nil
+}
+
+func (t *TestGitCodeSearcher) SearchGitCode(ctx context.Context, option metav1alpha1.GitCodeSearchOption, listOption metav1alpha1.ListOptions) (metav1alpha1.GitCodeSearchResultList, error) {
+	t.option, t.listOption = option, listOption
+	return metav1alpha1.GitCodeSearchResultList{
+		Items: []metav1alpha1.GitCodeSearchResult{
+			{
+				Spec: metav1alpha1.GitCodeSearchResultSpec{
+					GitRepo:   option.GitRepo,
+					Path:      "main.go",
+					Fragments: []metav1alpha1.GitCodeSearchFragment{{Line: 1, Content: option.Query}},
+				},
+			},
+		},
+	}, nil
+}
+
+type TestGitBranch struct {
+	option metav1alpha1.GitBranchOption
+}
+
+func (t *TestGitBranch) Path() string {
+	return "test-20"
+}
+
+func (t *TestGitBranch) Setup(_ context.Context, _ *zap.SugaredLogger) error {
+	return nil
+}
+
+func (t *TestGitBranch) DeleteGitBranch(ctx context.Context, option metav1alpha1.GitBranchOption) error {
+	t.option = option
+	return nil
+}
+
+func (t *TestGitBranch) ProtectGitBranch(ctx context.Context, payload metav1alpha1.BranchProtectionPayload) (metav1alpha1.GitBranch, error) {
+	t.option = payload.GitBranchOption
+	branch := metav1alpha1.GitBranch{}
+	branch.Spec.GitRepo = payload.GitRepo
+	branch.Spec.Name = payload.Branch
+	branch.Spec.RequiredApprovals = payload.RequiredApprovals
+	return branch, nil
+}
+
+func (t *TestGitBranch) UnprotectGitBranch(ctx context.Context, option metav1alpha1.GitBranchOption) (metav1alpha1.GitBranch, error) {
+	t.option = option
+	return metav1alpha1.GitBranch{}, nil
+}
+
+type TestGitRepoFile struct {
+}
+
+func (t *TestGitRepoFile) Path() string {
+	return "test-21"
+}
+
+func (t *TestGitRepoFile) Setup(_ context.Context, _ *zap.SugaredLogger) error {
+	return nil
+}
+
+func (t *TestGitRepoFile) commit(filepath string) metav1alpha1.GitCommit {
+	commit := metav1alpha1.GitCommit{}
+	commit.Spec.Message = &filepath
+	return commit
+}
+
+func (t *TestGitRepoFile) CreateGitRepoFile(ctx context.Context, payload metav1alpha1.CreateRepoFilePayload) (metav1alpha1.GitCommit, error) {
+	return t.commit(payload.FilePath), nil
+}
+
+func (t *TestGitRepoFile) UpdateGitRepoFile(ctx context.Context, payload metav1alpha1.UpdateRepoFilePayload) (metav1alpha1.GitCommit, error) {
+	return t.commit(payload.FilePath), nil
+}
+
+func (t *TestGitRepoFile) DeleteGitRepoFile(ctx context.Context, payload metav1alpha1.DeleteRepoFilePayload) (metav1alpha1.GitCommit, error) {
+	return t.commit(payload.FilePath), nil
+}
+
+type TestGitPullRequestNote struct {
+	deleted metav1alpha1.GitPullRequestCommentOption
+}
+
+func (t *TestGitPullRequestNote) Path() string {
+	return "test-22"
+}
+
+func (t *TestGitPullRequestNote) Setup(_ context.Context, _ *zap.SugaredLogger) error {
+	return nil
+}
+
+func (t *TestGitPullRequestNote) CreatePullRequestComment(ctx context.Context, option metav1alpha1.CreatePullRequestCommentPayload) (metav1alpha1.GitPullRequestNote, error) {
+	return metav1alpha1.GitPullRequestNote{
+		Spec: metav1alpha1.GitPullRequestNoteSpec{ID: 1, Body: option.Body, Position: option.Position},
+	}, nil
+}
+
+func (t *TestGitPullRequestNote) ListPullRequestComment(ctx context.Context, option metav1alpha1.GitPullRequestOption, listOption metav1alpha1.ListOptions) (metav1alpha1.GitPullRequestNoteList, error) {
+	list := metav1alpha1.GitPullRequestNoteList{}
+	list.TotalItems = 3
+	for id := 1; id <= list.TotalItems; id++ {
+		if listOption.ItemsPerPage > 0 && (id-1)/listOption.ItemsPerPage+1 != listOption.Page {
+			continue
+		}
+		list.Items = append(list.Items, metav1alpha1.GitPullRequestNote{Spec: metav1alpha1.GitPullRequestNoteSpec{ID: id}})
+	}
+	return list, nil
+}
+
+func (t *TestGitPullRequestNote) UpdatePullRequestComment(ctx context.Context, payload metav1alpha1.UpdatePullRequestCommentPayload) (metav1alpha1.GitPullRequestNote, error) {
+	return metav1alpha1.GitPullRequestNote{
+		Spec: metav1alpha1.GitPullRequestNoteSpec{ID: payload.CommentID, Body: payload.Body},
+	}, nil
+}
+
+func (t *TestGitPullRequestNote) DeletePullRequestComment(ctx context.Context, option metav1alpha1.GitPullRequestCommentOption) error {
+	t.deleted = option
+	return nil
+}
+
+type TestGitCommit struct {
+	option metav1alpha1.GitCommitListOption
+}
+
+func (t *TestGitCommit) Path() string {
+	return "test-23"
+}
+
+func (t *TestGitCommit) Setup(_ context.Context, _ *zap.SugaredLogger) error {
+	return nil
+}
+
+func (t *TestGitCommit) ListGitCommit(ctx context.Context, option metav1alpha1.GitCommitListOption, listOption metav1alpha1.ListOptions) (metav1alpha1.GitCommitList, error) {
+	t.option = option
+	return metav1alpha1.GitCommitList{}, nil
+}
+
+type TestGitPullRequestLifecycle struct {
+	action string
+	option metav1alpha1.GitPullRequestOption
+	merge  metav1alpha1.MergePullRequestParams
+	update metav1alpha1.UpdatePullRequestParams
+}
+
+func (t *TestGitPullRequestLifecycle) Path() string {
+	return "test-24"
+}
+
+func (t *TestGitPullRequestLifecycle) Setup(_ context.Context, _ *zap.SugaredLogger) error {
+	return nil
+}
+
+func (t *TestGitPullRequestLifecycle) MergePullRequest(ctx context.Context, payload metav1alpha1.MergePullRequestPayload) (metav1alpha1.GitPullRequest, error) {
+	t.action, t.merge = "merge", payload.MergePullRequestParams
+	t.option = metav1alpha1.GitPullRequestOption{GitRepo: payload.GitRepo, Index: payload.Index}
+	return metav1alpha1.GitPullRequest{}, nil
+}
+
+func (t *TestGitPullRequestLifecycle) ClosePullRequest(ctx context.Context, option metav1alpha1.GitPullRequestOption) (metav1alpha1.GitPullRequest, error) {
+	t.action, t.option = "close", option
+	return metav1alpha1.GitPullRequest{}, nil
+}
+
+func (t *TestGitPullRequestLifecycle) ReopenPullRequest(ctx context.Context, option metav1alpha1.GitPullRequestOption) (metav1alpha1.GitPullRequest, error) {
+	t.action, t.option = "reopen", option
+	return metav1alpha1.GitPullRequest{}, nil
+}
+
+func (t *TestGitPullRequestLifecycle) UpdatePullRequest(ctx context.Context, payload metav1alpha1.UpdatePullRequestPayload) (metav1alpha1.GitPullRequest, error) {
+	t.action, t.update = "update", payload.UpdatePullRequestParams
+	t.option = metav1alpha1.GitPullRequestOption{GitRepo: payload.GitRepo, Index: payload.Index}
+	return metav1alpha1.GitPullRequest{}, nil
+}
+
+type TestGitCommitStatusHandler struct {
+	payload  metav1alpha1.CreateCommitStatusPayload
+	statuses []metav1alpha1.GitCommitStatus
+}
+
+func (t *TestGitCommitStatusHandler) Path() string {
+	return "test-25"
+}
+
+func (t *TestGitCommitStatusHandler) Setup(_ context.Context, _ *zap.SugaredLogger) error {
+	return nil
+}
+
+func (t *TestGitCommitStatusHandler) ListGitCommitStatus(ctx context.Context, option metav1alpha1.GitCommitOption, listOption metav1alpha1.ListOptions) (metav1alpha1.GitCommitStatusList, error) {
+	return metav1alpha1.GitCommitStatusList{Items: t.statuses}, nil
+}
+
+func (t *TestGitCommitStatusHandler) CreateGitCommitStatus(ctx context.Context, payload metav1alpha1.CreateCommitStatusPayload) (metav1alpha1.GitCommitStatus, error) {
+	t.payload = payload
+	status := metav1alpha1.GitCommitStatus{
+		Spec: metav1alpha1.GitCommitStatusSpec{
+			GitCommitBasicInfo: payload.GitCommitBasicInfo,
+			State:              payload.State,
+			Context:            payload.Context,
+			TargetURL:          payload.TargetURL,
+			Description:        payload.Description,
+			Coverage:           payload.Coverage,
+		},
+	}
+	t.statuses = append(t.statuses, status)
+	return status, nil
+}
+
+type TestGitRepoTree struct {
+	option metav1alpha1.GitRepoTreeOption
+}
+
+func (t *TestGitRepoTree) Path() string {
+	return "test-26"
+}
+
+func (t *TestGitRepoTree) Setup(_ context.Context, _ *zap.SugaredLogger) error {
+	return nil
+}
+
+func (t *TestGitRepoTree) ListGitRepoTree(ctx context.Context, option metav1alpha1.GitRepoTreeOption, listOption metav1alpha1.ListOptions) (metav1alpha1.GitRepoTreeEntryList, error) {
+	t.option = option
+	return metav1alpha1.GitRepoTreeEntryList{
+		Items: []metav1alpha1.GitRepoTreeEntry{
+			{Spec: metav1alpha1.GitRepoTreeEntrySpec{Name: "go.mod", Path: option.Path + "/go.mod", Type: metav1alpha1.GitRepoTreeEntryTypeFile}},
+		},
+	}, nil
+}
+
+type TestGitCompare struct {
+}
+
+func (t *TestGitCompare) Path() string {
+	return "test-27"
+}
+
+func (t *TestGitCompare) Setup(_ context.Context, _ *zap.SugaredLogger) error {
+	return nil
+}
+
+func (t *TestGitCompare) GetGitCompare(ctx context.Context, option metav1alpha1.GitCompareOption) (metav1alpha1.GitCompare, error) {
+	return metav1alpha1.GitCompare{
+		Spec: metav1alpha1.GitCompareSpec{
+			GitRepo: option.GitRepo,
+			Base:    option.Base,
+			Head:    option.Head,
+			Files:   []metav1alpha1.GitChangedFile{{Path: "go.mod", Status: metav1alpha1.GitChangedFileStatusModified, Additions: 1}},
+		},
+	}, nil
+}
+
+type TestGitPullRequestChangeLister struct {
+	listOption metav1alpha1.ListOptions
+}
+
+func (t *TestGitPullRequestChangeLister) Path() string {
+	return "test-28"
+}
+
+func (t *TestGitPullRequestChangeLister) Setup(_ context.Context, _ *zap.SugaredLogger) error {
+	return nil
+}
+
+func (t *TestGitPullRequestChangeLister) ListPullRequestCommits(ctx context.Context, option metav1alpha1.GitPullRequestOption, listOption metav1alpha1.ListOptions) (metav1alpha1.GitCommitList, error) {
+	t.listOption = listOption
+	sha := strconv.Itoa(option.Index)
+	commit := metav1alpha1.GitCommit{}
+	commit.Spec.SHA = &sha
+	return metav1alpha1.GitCommitList{Items: []metav1alpha1.GitCommit{commit}}, nil
+}
+
+func (t *TestGitPullRequestChangeLister) ListPullRequestFiles(ctx context.Context, option metav1alpha1.GitPullRequestOption, listOption metav1alpha1.ListOptions) (metav1alpha1.GitChangedFileList, error) {
+	t.listOption = listOption
+	patch := "@@ -1 +1 @@"
+	return metav1alpha1.GitChangedFileList{
+		Items: []metav1alpha1.GitChangedFile{{Path: "go.mod", Status: metav1alpha1.GitChangedFileStatusModified, Patch: &patch}},
+	}, nil
 }
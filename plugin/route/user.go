@@ -0,0 +1,98 @@
+/*
+Copyright 2021 The Katanomi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package route
+
+import (
+	"net/http"
+
+	restfulspec "github.com/emicklei/go-restful-openapi/v2"
+	"github.com/emicklei/go-restful/v3"
+	metav1alpha1 "github.com/katanomi/pkg/apis/meta/v1alpha1"
+	kerrors "github.com/katanomi/pkg/errors"
+	"github.com/katanomi/pkg/plugin/client"
+)
+
+type userGet struct {
+	impl client.UserGetter
+	tags []string
+}
+
+// NewUserGet create a get current user route with plugin client
+func NewUserGet(impl client.UserGetter) Route {
+	return &userGet{
+		tags: []string{"users"},
+		impl: impl,
+	}
+}
+
+func (u *userGet) Register(ws *restful.WebService) {
+	ws.Route(ws.GET("/user").To(u.GetCurrentUser).
+		// docs
+		Doc("GetCurrentUser").
+		Metadata(restfulspec.KeyOpenAPITags, u.tags).
+		Returns(http.StatusOK, "OK", metav1alpha1.User{}))
+}
+
+// GetCurrentUser http handler for getting the user behind the auth
+func (u *userGet) GetCurrentUser(request *restful.Request, response *restful.Response) {
+	user, err := u.impl.GetCurrentUser(request.Request.Context())
+	if err != nil {
+		kerrors.HandleError(request, response, err)
+		return
+	}
+
+	response.WriteHeaderAndEntity(http.StatusOK, user)
+}
+
+type userList struct {
+	impl client.UserLister
+	tags []string
+}
+
+// NewUserList create a list user route with plugin client
+func NewUserList(impl client.UserLister) Route {
+	return &userList{
+		tags: []string{"users"},
+		impl: impl,
+	}
+}
+
+func (u *userList) Register(ws *restful.WebService) {
+	ws.Route(
+		ListOptionsDocs(
+			ws.GET("/users").To(u.ListUsers).
+				Param(ws.QueryParameter(metav1alpha1.UserSearchNameKey, "filter users by name").DataType("string")).
+				Param(ws.QueryParameter(metav1alpha1.UserSearchEmailKey, "filter users by email").DataType("string")).
+				// docs
+				Doc("ListUsers").
+				Metadata(restfulspec.KeyOpenAPITags, u.tags).
+				Returns(http.StatusOK, "OK", metav1alpha1.UserList{}),
+		),
+	)
+}
+
+// ListUsers http handler for searching users
+func (u *userList) ListUsers(request *restful.Request, response *restful.Response) {
+	option := GetListOptionsFromRequest(request)
+	users, err := u.impl.ListUsers(request.Request.Context(), option)
+	if err != nil {
+		kerrors.HandleError(request, response, err)
+		return
+	}
+
+	response.WriteHeaderAndEntity(http.StatusOK, users)
+}
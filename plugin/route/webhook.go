@@ -0,0 +1,151 @@
+/*
+Copyright 2021 The Katanomi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package route
+
+import (
+	"context"
+	"net/http"
+
+	restfulspec "github.com/emicklei/go-restful-openapi/v2"
+	"github.com/emicklei/go-restful/v3"
+	metav1alpha1 "github.com/katanomi/pkg/apis/meta/v1alpha1"
+	kerrors "github.com/katanomi/pkg/errors"
+	"github.com/katanomi/pkg/plugin/client"
+	corev1 "k8s.io/api/core/v1"
+)
+
+type webhookRegister struct {
+	impl client.WebhookRegister
+	tags []string
+}
+
+// NewWebhookRegister create webhook register routes with plugin client
+func NewWebhookRegister(impl client.WebhookRegister) Route {
+	return &webhookRegister{
+		tags: []string{"webhooks"},
+		impl: impl,
+	}
+}
+
+func (w *webhookRegister) Register(ws *restful.WebService) {
+	ws.Route(ws.POST("/webhooks").To(w.CreateWebhook).
+		// docs
+		Doc("CreateWebhook").
+		Metadata(restfulspec.KeyOpenAPITags, w.tags).
+		Reads(metav1alpha1.WebhookRegisterSpec{}, "WebhookRegisterSpec").
+		Returns(http.StatusOK, "OK", metav1alpha1.WebhookRegisterStatus{}))
+	ws.Route(ws.PUT("/webhooks").To(w.UpdateWebhook).
+		// docs
+		Doc("UpdateWebhook").
+		Metadata(restfulspec.KeyOpenAPITags, w.tags).
+		Reads(metav1alpha1.WebhookRegisterSpec{}, "WebhookRegisterSpec").
+		Returns(http.StatusOK, "OK", metav1alpha1.WebhookRegisterStatus{}))
+	ws.Route(ws.DELETE("/webhooks").To(w.DeleteWebhook).
+		// docs
+		Doc("DeleteWebhook").
+		Metadata(restfulspec.KeyOpenAPITags, w.tags).
+		Reads(metav1alpha1.WebhookRegisterSpec{}, "WebhookRegisterSpec").
+		Returns(http.StatusOK, "OK", nil))
+}
+
+// CreateWebhook http handler for create webhook
+func (w *webhookRegister) CreateWebhook(request *restful.Request, response *restful.Response) {
+	w.handle(request, response, w.impl.CreateWebhook)
+}
+
+// UpdateWebhook http handler for update webhook
+func (w *webhookRegister) UpdateWebhook(request *restful.Request, response *restful.Response) {
+	w.handle(request, response, w.impl.UpdateWebhook)
+}
+
+// DeleteWebhook http handler for delete webhook
+func (w *webhookRegister) DeleteWebhook(request *restful.Request, response *restful.Response) {
+	spec := metav1alpha1.WebhookRegisterSpec{}
+	if err := request.ReadEntity(&spec); err != nil {
+		kerrors.HandleError(request, response, err)
+		return
+	}
+
+	ctx := request.Request.Context()
+	if err := w.impl.DeleteWebhook(ctx, spec, secretFromContext(ctx)); err != nil {
+		kerrors.HandleError(request, response, err)
+		return
+	}
+
+	response.WriteHeader(http.StatusOK)
+}
+
+type webhookRegisterFunc func(context.Context, metav1alpha1.WebhookRegisterSpec, corev1.Secret) (metav1alpha1.WebhookRegisterStatus, error)
+
+func (w *webhookRegister) handle(request *restful.Request, response *restful.Response, register webhookRegisterFunc) {
+	spec := metav1alpha1.WebhookRegisterSpec{}
+	if err := request.ReadEntity(&spec); err != nil {
+		kerrors.HandleError(request, response, err)
+		return
+	}
+
+	ctx := request.Request.Context()
+	status, err := register(ctx, spec, secretFromContext(ctx))
+	if err != nil {
+		kerrors.HandleError(request, response, err)
+		return
+	}
+
+	response.WriteHeaderAndEntity(http.StatusOK, status)
+}
+
+// secretFromContext returns the secret sent by the plugin client, empty if not provided
+func secretFromContext(ctx context.Context) corev1.Secret {
+	if auth := client.ExtractAuth(ctx); auth != nil {
+		return auth.ToSecret()
+	}
+	return corev1.Secret{}
+}
+
+type webhookResourceDiffer struct {
+	impl client.WebhookResourceDiffer
+	tags []string
+}
+
+// NewWebhookResourceDiffer create a webhook resource compare route with plugin client
+func NewWebhookResourceDiffer(impl client.WebhookResourceDiffer) Route {
+	return &webhookResourceDiffer{
+		tags: []string{"webhooks"},
+		impl: impl,
+	}
+}
+
+func (w *webhookResourceDiffer) Register(ws *restful.WebService) {
+	ws.Route(ws.POST("/webhooks/resources/compare").To(w.IsSameResource).
+		// docs
+		Doc("IsSameResource").
+		Metadata(restfulspec.KeyOpenAPITags, w.tags).
+		Reads(metav1alpha1.WebhookResourceComparePayload{}, "WebhookResourceComparePayload").
+		Returns(http.StatusOK, "OK", metav1alpha1.WebhookResourceCompareResult{}))
+}
+
+// IsSameResource http handler for comparing two webhook resources
+func (w *webhookResourceDiffer) IsSameResource(request *restful.Request, response *restful.Response) {
+	payload := metav1alpha1.WebhookResourceComparePayload{}
+	if err := request.ReadEntity(&payload); err != nil {
+		kerrors.HandleError(request, response, err)
+		return
+	}
+
+	same := w.impl.IsSameResource(request.Request.Context(), payload.Source, payload.Target)
+	response.WriteHeaderAndEntity(http.StatusOK, metav1alpha1.WebhookResourceCompareResult{Same: same})
+}
@@ -0,0 +1,182 @@
+/*
+Copyright 2021 The Katanomi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package route
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	restfulspec "github.com/emicklei/go-restful-openapi/v2"
+	"github.com/emicklei/go-restful/v3"
+	kerrors "github.com/katanomi/pkg/errors"
+	"github.com/katanomi/pkg/plugin/client"
+	"k8s.io/apimachinery/pkg/api/errors"
+)
+
+const (
+	// GithubSignatureHeader header with the HMAC-SHA256 signature of github webhooks
+	GithubSignatureHeader = "X-Hub-Signature-256"
+	// GitlabTokenHeader header with the secret token of gitlab webhooks
+	GitlabTokenHeader = "X-Gitlab-Token"
+	// GiteaSignatureHeader header with the HMAC-SHA256 signature of gitea webhooks
+	GiteaSignatureHeader = "X-Gitea-Signature"
+	// GogsSignatureHeader header with the HMAC-SHA256 signature of gogs webhooks
+	GogsSignatureHeader = "X-Gogs-Signature"
+
+	githubSignaturePrefix = "sha256="
+
+	// MaxWebhookBodySize max size in bytes of a webhook request body
+	MaxWebhookBodySize = 10 << 20
+)
+
+type webhookReceiver struct {
+	impl         client.WebhookReceiver
+	secretGetter client.WebhookSecretGetter
+	tags         []string
+}
+
+// NewWebhookReceiver create a webhook receiving route with plugin client
+// the plugin must implement client.WebhookSecretGetter to provide the secret used
+// to verify the request signature before calling ReceiveWebhook,
+// otherwise all requests are answered with 501
+func NewWebhookReceiver(impl client.WebhookReceiver) Route {
+	receiver := &webhookReceiver{
+		tags: []string{"webhooks"},
+		impl: impl,
+	}
+	if getter, ok := impl.(client.WebhookSecretGetter); ok {
+		receiver.secretGetter = getter
+	}
+	return receiver
+}
+
+func (w *webhookReceiver) Register(ws *restful.WebService) {
+	ws.Route(ws.POST("/webhooks/receive").To(w.ReceiveWebhook).
+		// tools may send webhooks form encoded, e.g. github by default
+		Consumes("*/*").
+		// docs
+		Doc("ReceiveWebhook").
+		Metadata(restfulspec.KeyOpenAPITags, w.tags).
+		Returns(http.StatusOK, "OK", nil).
+		Returns(http.StatusBadRequest, "Unreadable body", nil).
+		Returns(http.StatusUnauthorized, "Invalid signature", nil).
+		Returns(http.StatusRequestEntityTooLarge, "Body too large", nil).
+		Returns(http.StatusNotImplemented, "Webhook secret lookup not implemented", nil))
+}
+
+// ReceiveWebhook http handler for receiving webhooks
+func (w *webhookReceiver) ReceiveWebhook(request *restful.Request, response *restful.Response) {
+	ctx := request.Request.Context()
+
+	if w.secretGetter == nil {
+		err := errors.NewGenericServerResponse(http.StatusNotImplemented, request.Request.Method, kerrors.RESTClientGroupResource,
+			"", "plugin does not implement webhook secret lookup", 0, false)
+		kerrors.HandleError(request, response, err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(http.MaxBytesReader(response, request.Request.Body, MaxWebhookBodySize))
+	if err != nil {
+		if isRequestBodyTooLarge(err) {
+			err = errors.NewRequestEntityTooLargeError(err.Error())
+		} else {
+			err = errors.NewBadRequest(err.Error())
+		}
+		kerrors.HandleError(request, response, err)
+		return
+	}
+
+	request.Request.Body = ioutil.NopCloser(bytes.NewReader(body))
+	secret, err := w.secretGetter.GetWebhookSecret(ctx, request)
+	if err != nil {
+		kerrors.HandleError(request, response, err)
+		return
+	}
+	if err = VerifyWebhookSignature(request.Request.Header, body, secret); err != nil {
+		kerrors.HandleError(request, response, err)
+		return
+	}
+
+	request.Request.Body = ioutil.NopCloser(bytes.NewReader(body))
+	event, err := w.impl.ReceiveWebhook(ctx, request, secret)
+	if err != nil {
+		kerrors.HandleError(request, response, err)
+		return
+	}
+
+	response.WriteHeaderAndEntity(http.StatusOK, event)
+}
+
+// VerifyWebhookSignature verifies a webhook request using the known signature headers:
+// github X-Hub-Signature-256, gitlab X-Gitlab-Token and gitea/gogs HMAC-SHA256 signatures.
+// An unauthorized error is returned when the secret is empty,
+// no known header is present or the signature does not match
+func VerifyWebhookSignature(header http.Header, body []byte, secret string) error {
+	if secret == "" {
+		return errors.NewUnauthorized("webhook secret not configured")
+	}
+
+	if signature := header.Get(GithubSignatureHeader); signature != "" {
+		if !strings.HasPrefix(signature, githubSignaturePrefix) {
+			return errors.NewUnauthorized("invalid signature format in " + GithubSignatureHeader)
+		}
+		return verifyHMACSHA256(strings.TrimPrefix(signature, githubSignaturePrefix), body, secret, GithubSignatureHeader)
+	}
+
+	if token := header.Get(GitlabTokenHeader); token != "" {
+		if subtle.ConstantTimeCompare([]byte(token), []byte(secret)) != 1 {
+			return errors.NewUnauthorized("token mismatch in " + GitlabTokenHeader)
+		}
+		return nil
+	}
+
+	for _, key := range []string{GiteaSignatureHeader, GogsSignatureHeader} {
+		if signature := header.Get(key); signature != "" {
+			return verifyHMACSHA256(signature, body, secret, key)
+		}
+	}
+
+	return errors.NewUnauthorized("webhook signature not found")
+}
+
+// verifyHMACSHA256 compares a hex encoded HMAC-SHA256 signature with the one calculated from body
+func verifyHMACSHA256(signature string, body []byte, secret string, header string) error {
+	actual, err := hex.DecodeString(signature)
+	if err != nil {
+		return errors.NewUnauthorized("invalid signature format in " + header)
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	if !hmac.Equal(actual, mac.Sum(nil)) {
+		return errors.NewUnauthorized("signature mismatch in " + header)
+	}
+	return nil
+}
+
+// isRequestBodyTooLarge returns true if the error was returned by
+// http.MaxBytesReader after the body exceeded its limit
+func isRequestBodyTooLarge(err error) bool {
+	// http.MaxBytesError is not available before go 1.19
+	return err != nil && err.Error() == "http: request body too large"
+}
@@ -0,0 +1,267 @@
+/*
+Copyright 2021 The Katanomi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package route
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	cloudevent "github.com/cloudevents/sdk-go/v2"
+	"github.com/emicklei/go-restful/v3"
+	"github.com/katanomi/pkg/plugin/client"
+	. "github.com/onsi/gomega"
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/api/errors"
+)
+
+func sign(body, secret string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(body))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestVerifyWebhookSignature(t *testing.T) {
+	body := `{"ref":"refs/heads/main"}`
+	table := map[string]struct {
+		Header  http.Header
+		Secret  string
+		IsValid bool
+	}{
+		"Empty secret": {
+			Header:  http.Header{GiteaSignatureHeader: []string{sign(body, "")}},
+			Secret:  "",
+			IsValid: false,
+		},
+		"Missing signature": {
+			Header:  http.Header{},
+			Secret:  "secret",
+			IsValid: false,
+		},
+		"Valid github signature": {
+			Header:  http.Header{GithubSignatureHeader: []string{"sha256=" + sign(body, "secret")}},
+			Secret:  "secret",
+			IsValid: true,
+		},
+		"Github signature without prefix": {
+			Header:  http.Header{GithubSignatureHeader: []string{sign(body, "secret")}},
+			Secret:  "secret",
+			IsValid: false,
+		},
+		"Github signature with another secret": {
+			Header:  http.Header{GithubSignatureHeader: []string{"sha256=" + sign(body, "other")}},
+			Secret:  "secret",
+			IsValid: false,
+		},
+		"Valid gitlab token": {
+			Header:  http.Header{GitlabTokenHeader: []string{"secret"}},
+			Secret:  "secret",
+			IsValid: true,
+		},
+		"Invalid gitlab token": {
+			Header:  http.Header{GitlabTokenHeader: []string{"other"}},
+			Secret:  "secret",
+			IsValid: false,
+		},
+		"Valid gitea signature": {
+			Header:  http.Header{GiteaSignatureHeader: []string{sign(body, "secret")}},
+			Secret:  "secret",
+			IsValid: true,
+		},
+		"Valid gogs signature": {
+			Header:  http.Header{GogsSignatureHeader: []string{sign(body, "secret")}},
+			Secret:  "secret",
+			IsValid: true,
+		},
+		"Gogs signature not hex encoded": {
+			Header:  http.Header{GogsSignatureHeader: []string{"not-hex"}},
+			Secret:  "secret",
+			IsValid: false,
+		},
+	}
+
+	for name, item := range table {
+		test := item
+		t.Run(name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+
+			err := VerifyWebhookSignature(test.Header, []byte(body), test.Secret)
+			if test.IsValid {
+				g.Expect(err).To(BeNil())
+			} else {
+				g.Expect(errors.IsUnauthorized(err)).To(BeTrue())
+			}
+		})
+	}
+}
+
+func TestReceiveWebhook(t *testing.T) {
+	body := `{"ref":"refs/heads/main"}`
+	formBody := url.Values{"payload": []string{body}}.Encode()
+	testCases := map[string]struct {
+		plugin      client.Interface
+		body        string
+		reader      io.Reader
+		contentType string
+		header      string
+		signature   string
+		code        int
+	}{
+		"valid signature": {
+			plugin:    &TestWebhookReceiver{Secret: "secret"},
+			body:      body,
+			signature: sign(body, "secret"),
+			code:      http.StatusOK,
+		},
+		"invalid signature": {
+			plugin:    &TestWebhookReceiver{Secret: "secret"},
+			body:      body,
+			signature: sign(body, "other"),
+			code:      http.StatusUnauthorized,
+		},
+		"empty secret": {
+			plugin:    &TestWebhookReceiver{Secret: ""},
+			body:      body,
+			signature: sign(body, ""),
+			code:      http.StatusUnauthorized,
+		},
+		"no secret getter": {
+			plugin:    &TestWebhookReceiverWithoutSecret{},
+			body:      body,
+			signature: sign(body, ""),
+			code:      http.StatusNotImplemented,
+		},
+		"body too large": {
+			plugin:    &TestWebhookReceiver{Secret: "secret"},
+			body:      strings.Repeat("a", MaxWebhookBodySize+1),
+			signature: sign(body, "secret"),
+			code:      http.StatusRequestEntityTooLarge,
+		},
+		"unreadable body": {
+			plugin:    &TestWebhookReceiver{Secret: "secret"},
+			reader:    iotest.ErrReader(io.ErrUnexpectedEOF),
+			signature: sign(body, "secret"),
+			code:      http.StatusBadRequest,
+		},
+		"form encoded github webhook": {
+			plugin:      &TestWebhookReceiver{Secret: "secret"},
+			body:        formBody,
+			contentType: "application/x-www-form-urlencoded",
+			header:      GithubSignatureHeader,
+			signature:   "sha256=" + sign(formBody, "secret"),
+			code:        http.StatusOK,
+		},
+	}
+
+	for name, item := range testCases {
+		test := item
+		t.Run(name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+
+			ws, err := NewService(test.plugin)
+			g.Expect(err).To(BeNil())
+
+			container := restful.NewContainer()
+			container.Add(ws)
+
+			reader, contentType, header := test.reader, test.contentType, test.header
+			if reader == nil {
+				reader = strings.NewReader(test.body)
+			}
+			if contentType == "" {
+				contentType = "application/json"
+			}
+			if header == "" {
+				header = GiteaSignatureHeader
+			}
+
+			httpRequest, _ := http.NewRequest("POST", "/plugins/v1alpha1/"+test.plugin.Path()+"/webhooks/receive", reader)
+			httpRequest.Header.Set("Accept", "application/json")
+			httpRequest.Header.Set("Content-Type", contentType)
+			httpRequest.Header.Set(header, test.signature)
+
+			httpWriter := httptest.NewRecorder()
+
+			container.Dispatch(httpWriter, httpRequest)
+			g.Expect(httpWriter.Code).To(Equal(test.code))
+
+			if test.code == http.StatusOK {
+				event := cloudevent.NewEvent()
+				err = json.Unmarshal(httpWriter.Body.Bytes(), &event)
+				g.Expect(err).To(BeNil())
+				g.Expect(event.Type()).To(Equal("dev.katanomi.test"))
+				g.Expect(string(event.Data())).To(Equal(test.body))
+			}
+		})
+	}
+}
+
+type TestWebhookReceiver struct {
+	Secret string
+}
+
+func (t *TestWebhookReceiver) Path() string {
+	return "test-7"
+}
+
+func (t *TestWebhookReceiver) Setup(_ context.Context, _ *zap.SugaredLogger) error {
+	return nil
+}
+
+func (t *TestWebhookReceiver) GetWebhookSecret(ctx context.Context, req *restful.Request) (string, error) {
+	// consume the body to make sure it is restored for ReceiveWebhook
+	_, err := ioutil.ReadAll(req.Request.Body)
+	return t.Secret, err
+}
+
+func (t *TestWebhookReceiver) ReceiveWebhook(ctx context.Context, req *restful.Request, secret string) (cloudevent.Event, error) {
+	data, err := ioutil.ReadAll(req.Request.Body)
+	if err != nil {
+		return cloudevent.Event{}, err
+	}
+	event := cloudevent.NewEvent()
+	event.SetID("1")
+	event.SetSource("test")
+	event.SetType("dev.katanomi.test")
+	err = event.SetData(cloudevent.ApplicationJSON, data)
+	return event, err
+}
+
+type TestWebhookReceiverWithoutSecret struct {
+}
+
+func (t *TestWebhookReceiverWithoutSecret) Path() string {
+	return "test-19"
+}
+
+func (t *TestWebhookReceiverWithoutSecret) Setup(_ context.Context, _ *zap.SugaredLogger) error {
+	return nil
+}
+
+func (t *TestWebhookReceiverWithoutSecret) ReceiveWebhook(ctx context.Context, req *restful.Request, secret string) (cloudevent.Event, error) {
+	return cloudevent.NewEvent(), nil
+}